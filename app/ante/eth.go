@@ -30,6 +30,8 @@ import (
 	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -69,8 +71,12 @@ func VerifyEthAccount(
 			acc := ak.NewAccountWithAddress(ctx, from)
 			ak.SetAccount(ctx, acc)
 		} else if acct.IsContract() {
-			return errorsmod.Wrapf(errortypes.ErrInvalidType,
-				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
+			// EIP-7702 delegated accounts are still EOAs
+			code := evmKeeper.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+			if _, ok := evmtypes.ParseDelegation(code); !ok {
+				return errorsmod.Wrapf(errortypes.ErrInvalidType,
+					"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
+			}
 		}
 
 		balance := evmKeeper.GetBalance(ctx, from, evmDenom)
@@ -238,6 +244,59 @@ func CheckEthCanTransfer(
 	return nil
 }
 
+// CheckEthBlobTx validates the EIP-4844 blob transactions against the active chain rules.
+// Only the versioned hashes are kept on chain, the blobs themselves are never part of the tx.
+// This AnteHandler decorator will fail if:
+// - any of the msgs is not a MsgEthereumTx
+// - a blob tx is included before the Cancun upgrade
+// - the max fee per blob gas is lower than the blob base fee
+func CheckEthBlobTx(tx sdk.Tx, rules params.Rules) error {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		tx := msgEthTx.AsTransaction()
+		if tx.Type() != ethtypes.BlobTxType {
+			continue
+		}
+		if !rules.IsCancun {
+			return errorsmod.Wrap(ethtypes.ErrTxTypeNotSupported, "blob tx not supported")
+		}
+
+		blobBaseFee := eip4844.CalcBlobFee(0)
+		if tx.BlobGasFeeCap().Cmp(blobBaseFee) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFee,
+				"max fee per blob gas less than block blob gas fee (%s < %s)",
+				tx.BlobGasFeeCap(), blobBaseFee,
+			)
+		}
+	}
+
+	return nil
+}
+
+// CheckEthSetCodeTx validates the EIP-7702 set code transactions against the active chain rules.
+// This AnteHandler decorator will fail if:
+// - any of the msgs is not a MsgEthereumTx
+// - a set code tx is included before the Prague upgrade
+func CheckEthSetCodeTx(tx sdk.Tx, rules params.Rules) error {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		if msgEthTx.TxType() == evmtypes.SetCodeTxType && !rules.IsPrague {
+			return errorsmod.Wrap(ethtypes.ErrTxTypeNotSupported, "set code tx not supported")
+		}
+	}
+
+	return nil
+}
+
 // canTransfer adapted the core.CanTransfer from go-ethereum
 func canTransfer(ctx sdk.Context, evmKeeper EVMKeeper, denom string, from common.Address, amount *big.Int) bool {
 	balance := evmKeeper.GetBalance(ctx, sdk.AccAddress(from.Bytes()), denom)
@@ -108,6 +108,14 @@ func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			return ctx, err
 		}
 
+		if err := CheckEthBlobTx(tx, rules); err != nil {
+			return ctx, err
+		}
+
+		if err := CheckEthSetCodeTx(tx, rules); err != nil {
+			return ctx, err
+		}
+
 		if err := VerifyEthSig(tx, ethSigner); err != nil {
 			return ctx, err
 		}
@@ -0,0 +1,149 @@
+package app_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Helios-Chain-Labs/ethermint/app"
+	"github.com/Helios-Chain-Labs/ethermint/crypto/ethsecp256k1"
+	srvconfig "github.com/Helios-Chain-Labs/ethermint/server/config"
+	srvflags "github.com/Helios-Chain-Labs/ethermint/server/flags"
+	"github.com/Helios-Chain-Labs/ethermint/tests"
+	"github.com/Helios-Chain-Labs/ethermint/testutil"
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// fundedGenesis returns a patch that funds the given accounts with the evm denom.
+func fundedGenesis(t *testing.T, keys []*ethsecp256k1.PrivKey, amount sdkmath.Int) func(*app.EthermintApp, app.GenesisState) app.GenesisState {
+	return func(a *app.EthermintApp, genesis app.GenesisState) app.GenesisState {
+		cdc := a.AppCodec()
+
+		var authGenesis authtypes.GenesisState
+		cdc.MustUnmarshalJSON(genesis[authtypes.ModuleName], &authGenesis)
+		var bankGenesis banktypes.GenesisState
+		cdc.MustUnmarshalJSON(genesis[banktypes.ModuleName], &bankGenesis)
+
+		accounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+		require.NoError(t, err)
+
+		coins := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, amount))
+		for _, key := range keys {
+			addr := sdk.AccAddress(key.PubKey().Address())
+			accounts = append(accounts, &ethermint.EthAccount{
+				BaseAccount: authtypes.NewBaseAccount(addr, nil, uint64(len(accounts)), 0),
+				CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).String(),
+			})
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+			bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+		}
+
+		packed, err := authtypes.PackAccounts(accounts)
+		require.NoError(t, err)
+		authGenesis.Accounts = packed
+		genesis[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenesis)
+		genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+		return genesis
+	}
+}
+
+// buildTransfers builds a block of conflicting transfers, every sender sends several txs
+// to a small set of shared recipients, some of them overspending the sender balance.
+func buildTransfers(t *testing.T, a *app.EthermintApp, keys []*ethsecp256k1.PrivKey, txsPerSender int) [][]byte {
+	chainID, err := ethermint.ParseChainID(testutil.TestnetChainID)
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	gasPrice := big.NewInt(10_000_000_000)
+
+	var txs [][]byte
+	for nonce := 0; nonce < txsPerSender; nonce++ {
+		for i, key := range keys {
+			// send to the next accounts, so the senders are also recipients
+			to := common.BytesToAddress(keys[(i+nonce+1)%len(keys)].PubKey().Address())
+			value := new(big.Int).Mul(big.NewInt(int64(nonce+1)), big.NewInt(1_000_000_000_000_000_000))
+			msg := evmtypes.NewTx(chainID, uint64(nonce), &to, value, 21000, gasPrice, nil, nil, nil, nil)
+			msg.From = key.PubKey().Address()
+			require.NoError(t, msg.Sign(signer, tests.NewSigner(key)))
+
+			tx, err := msg.BuildTx(a.TxConfig().NewTxBuilder(), evmtypes.DefaultEVMDenom)
+			require.NoError(t, err)
+			bz, err := a.TxConfig().TxEncoder()(tx)
+			require.NoError(t, err)
+			txs = append(txs, bz)
+		}
+	}
+	return txs
+}
+
+func TestBlockSTMExecutorMatchesSequential(t *testing.T) {
+	keys := make([]*ethsecp256k1.PrivKey, 8)
+	for i := range keys {
+		ecdsaPriv, err := crypto.ToECDSA(common.LeftPadBytes([]byte{byte(i + 1)}, 32))
+		require.NoError(t, err)
+		keys[i] = &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(ecdsaPriv)}
+	}
+	// the balances only cover some of the transfers
+	funds := sdkmath.NewIntWithDecimal(3, 18)
+
+	// both apps must start from the same genesis state
+	var genesis app.GenesisState
+	patch := fundedGenesis(t, keys, funds)
+	sequential := testutil.SetupWithOpts(false, func(a *app.EthermintApp, state app.GenesisState) app.GenesisState {
+		genesis = patch(a, state)
+		return genesis
+	}, nil)
+	parallel := testutil.SetupWithOpts(false, func(*app.EthermintApp, app.GenesisState) app.GenesisState {
+		return genesis
+	}, simtestutil.AppOptionsMap{
+		srvflags.EVMBlockExecutor:   srvconfig.BlockExecutorBlockSTM,
+		srvflags.EVMBlockSTMWorkers: 4,
+	})
+
+	for _, a := range []*app.EthermintApp{sequential, parallel} {
+		_, err := a.Commit()
+		require.NoError(t, err)
+	}
+	require.Equal(t, sequential.LastCommitID(), parallel.LastCommitID())
+
+	blockTime := time.Unix(1700000000, 0).UTC()
+	// a block of conflicting transfers followed by an empty one
+	blocks := [][][]byte{buildTransfers(t, sequential, keys, 4), nil}
+	for i, txs := range blocks {
+		height := sequential.LastBlockHeight() + 1
+		require.Equal(t, height, parallel.LastBlockHeight()+1, "block %d", i)
+		req := &abci.RequestFinalizeBlock{
+			Height: height,
+			Time:   blockTime.Add(time.Duration(height) * time.Second),
+			Txs:    txs,
+		}
+
+		expected, err := sequential.FinalizeBlock(req)
+		require.NoError(t, err)
+		actual, err := parallel.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		require.Len(t, actual.TxResults, len(expected.TxResults))
+		for i := range expected.TxResults {
+			require.Equal(t, expected.TxResults[i], actual.TxResults[i], fmt.Sprintf("tx %d at height %d", i, height))
+		}
+		require.Equal(t, expected.AppHash, actual.AppHash, "height %d", height)
+
+		for _, a := range []*app.EthermintApp{sequential, parallel} {
+			_, err := a.Commit()
+			require.NoError(t, err)
+		}
+	}
+}
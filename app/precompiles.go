@@ -0,0 +1,60 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package app
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/Helios-Chain-Labs/ethermint/precompiles"
+	evmkeeper "github.com/Helios-Chain-Labs/ethermint/x/evm/keeper"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// customContractFns returns the registry of the built-in precompiled contracts, a contract is only reachable once
+// its address is listed in the ActivePrecompiles param of the evm module, and its activation fork is active.
+//
+// The keepers are resolved when the contracts are created, so the registry can be built before them.
+func (app *EthermintApp) customContractFns() map[common.Address]evmkeeper.CustomContractFn {
+	return map[common.Address]evmkeeper.CustomContractFn{
+		precompiles.BankContractAddress: activeSince(isShanghai, func() evmtypes.StatefulPrecompiledContract {
+			return precompiles.NewBankContract(app.BankKeeper, app.appCodec, storetypes.KVGasConfig())
+		}),
+		precompiles.RelayerContractAddress: activeSince(isShanghai, func() evmtypes.StatefulPrecompiledContract {
+			return precompiles.NewRelayerContract(app.MsgServiceRouter(), app.appCodec, storetypes.KVGasConfig())
+		}),
+		precompiles.ICAContractAddress: activeSince(isShanghai, func() evmtypes.StatefulPrecompiledContract {
+			return precompiles.NewICAContract(app.ICAControllerKeeper, app.appCodec, storetypes.KVGasConfig())
+		}),
+	}
+}
+
+// isShanghai is the activation fork of the built-in precompiled contracts
+func isShanghai(rules ethparams.Rules) bool {
+	return rules.IsShanghai
+}
+
+// activeSince returns the generator of a contract which is not available before the fork
+func activeSince(fork func(ethparams.Rules) bool, fn func() evmtypes.StatefulPrecompiledContract) evmkeeper.CustomContractFn {
+	return func(_ sdk.Context, rules ethparams.Rules) evmtypes.StatefulPrecompiledContract {
+		if !fork(rules) {
+			return nil
+		}
+		return fn()
+	}
+}
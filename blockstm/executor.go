@@ -0,0 +1,127 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package blockstm
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// ExecuteBlock executes the transactions of a block optimistically in parallel and writes
+// the result to the storage, the result is identical to executing them sequentially.
+//
+// Every round executes the pending transactions in parallel against the multi-version
+// memory, then the longest prefix of transactions whose reads are still valid is committed.
+// The first invalid transaction only depends on committed ones, so it's guaranteed to be
+// committed in the next round, the other invalid ones are re-executed speculatively with it.
+func ExecuteBlock(
+	ctx context.Context,
+	blockSize int,
+	stores map[storetypes.StoreKey]int,
+	storage MultiStore,
+	executors int,
+	txExecutor TxExecutor,
+) error {
+	if blockSize == 0 {
+		return nil
+	}
+	if executors <= 0 {
+		executors = runtime.NumCPU()
+	}
+
+	mv := NewMVMemory(blockSize, stores, storage)
+
+	pending := make([]TxnIndex, blockSize)
+	for i := range pending {
+		pending[i] = TxnIndex(i)
+	}
+
+	var committed TxnIndex
+	for int(committed) < blockSize {
+		if err := executeRound(ctx, mv, pending, executors, txExecutor); err != nil {
+			return err
+		}
+
+		for int(committed) < blockSize && mv.ValidateReadSet(committed) {
+			if recovered := mv.panics[committed]; recovered != nil {
+				// the transaction panics when executed sequentially too
+				panic(recovered)
+			}
+			committed++
+		}
+
+		pending = pending[:0]
+		for txn := committed; int(txn) < blockSize; txn++ {
+			if !mv.ValidateReadSet(txn) {
+				pending = append(pending, txn)
+			}
+		}
+	}
+
+	mv.WriteSnapshot(storage)
+	return nil
+}
+
+// executeRound executes the transactions in parallel with a bounded number of workers.
+func executeRound(
+	ctx context.Context,
+	mv *MVMemory,
+	txns []TxnIndex,
+	executors int,
+	txExecutor TxExecutor,
+) error {
+	jobs := make(chan TxnIndex, len(txns))
+	for _, txn := range txns {
+		jobs <- txn
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for i := 0; i < executors && i < len(txns); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for txn := range jobs {
+				if ctx.Err() != nil {
+					return
+				}
+				executeTxn(mv, txn, txExecutor)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// executeTxn runs a new incarnation of the transaction and records its read and write sets,
+// panics are recovered since they could be caused by reading inconsistent state.
+func executeTxn(mv *MVMemory, txn TxnIndex, txExecutor TxExecutor) {
+	view := newMultiMVView(txn, mv)
+
+	var recovered any
+	func() {
+		defer func() {
+			recovered = recover()
+		}()
+		txExecutor(txn, view)
+	}()
+
+	mv.Record(txn, view.views, recovered)
+}
@@ -0,0 +1,201 @@
+package blockstm
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/transient"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	kvKey  = storetypes.NewKVStoreKey("acc")
+	objKey = storetypes.NewObjectStoreKey("obj")
+)
+
+type testMultiStore struct {
+	stores map[storetypes.StoreKey]storetypes.CacheWrap
+}
+
+func newTestMultiStore() testMultiStore {
+	return testMultiStore{stores: map[storetypes.StoreKey]storetypes.CacheWrap{
+		kvKey:  cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}),
+		objKey: transient.NewObjStore().CacheWrap(),
+	}}
+}
+
+func (ms testMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.stores[key].(storetypes.Store)
+}
+
+func (ms testMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return ms.stores[key].(storetypes.KVStore)
+}
+
+func (ms testMultiStore) GetObjKVStore(key storetypes.StoreKey) storetypes.ObjKVStore {
+	return ms.stores[key].(storetypes.ObjKVStore)
+}
+
+func accKey(i int) []byte {
+	return []byte(fmt.Sprintf("acc/%03d", i))
+}
+
+func getUint(store storetypes.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func setUint(store storetypes.KVStore, key []byte, v uint64) {
+	store.Set(key, binary.BigEndian.AppendUint64(nil, v))
+}
+
+type testTx struct {
+	from, to int
+	amount   uint64
+	// sum iterates the accounts and records their total in the object store
+	sum bool
+	// prune deletes the empty accounts
+	prune bool
+}
+
+// execute runs the transaction through a cache store, the way baseapp does.
+func (tx testTx) execute(txn TxnIndex, ms MultiStore) {
+	cache := ms.GetKVStore(kvKey).CacheWrap().(storetypes.CacheKVStore)
+	objStore := ms.GetObjKVStore(objKey)
+
+	switch {
+	case tx.sum:
+		var total uint64
+		it := cache.Iterator([]byte("acc/"), []byte("acc0"))
+		for ; it.Valid(); it.Next() {
+			total += binary.BigEndian.Uint64(it.Value())
+		}
+		it.Close()
+		objStore.Set([]byte(fmt.Sprintf("sum/%03d", txn)), total)
+	case tx.prune:
+		var empty [][]byte
+		it := cache.ReverseIterator([]byte("acc/"), []byte("acc0"))
+		for ; it.Valid(); it.Next() {
+			if binary.BigEndian.Uint64(it.Value()) == 0 {
+				empty = append(empty, it.Key())
+			}
+		}
+		it.Close()
+		for _, key := range empty {
+			cache.Delete(key)
+		}
+	default:
+		balance := getUint(cache, accKey(tx.from))
+		if balance < tx.amount {
+			return
+		}
+		setUint(cache, accKey(tx.from), balance-tx.amount)
+		setUint(cache, accKey(tx.to), getUint(cache, accKey(tx.to))+tx.amount)
+	}
+	cache.Write()
+}
+
+func randomBlock(r *rand.Rand, size, accounts int) []testTx {
+	txs := make([]testTx, size)
+	for i := range txs {
+		switch n := r.Intn(10); {
+		case n < 2:
+			txs[i] = testTx{sum: true}
+		case n == 2:
+			txs[i] = testTx{prune: true}
+		default:
+			txs[i] = testTx{from: r.Intn(accounts), to: r.Intn(accounts), amount: uint64(r.Intn(150))}
+		}
+	}
+	return txs
+}
+
+func genesis(ms testMultiStore, accounts int) {
+	for i := 0; i < accounts; i += 2 {
+		setUint(ms.GetKVStore(kvKey), accKey(i), 100)
+	}
+}
+
+func dump(ms testMultiStore) map[string]any {
+	result := make(map[string]any)
+	it := ms.GetKVStore(kvKey).Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		result[string(it.Key())] = binary.BigEndian.Uint64(it.Value())
+	}
+	it.Close()
+	objIt := ms.GetObjKVStore(objKey).Iterator(nil, nil)
+	for ; objIt.Valid(); objIt.Next() {
+		result[string(objIt.Key())] = objIt.Value()
+	}
+	objIt.Close()
+	return result
+}
+
+func TestExecuteBlock(t *testing.T) {
+	stores := map[storetypes.StoreKey]int{kvKey: 0, objKey: 1}
+
+	testCases := []struct {
+		name      string
+		blockSize int
+		accounts  int
+		executors int
+	}{
+		{"empty block", 0, 10, 4},
+		{"single executor", 100, 10, 1},
+		{"high contention", 200, 3, 8},
+		{"low contention", 500, 400, 8},
+		{"default executors", 300, 50, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for seed := int64(0); seed < 5; seed++ {
+				txs := randomBlock(rand.New(rand.NewSource(seed)), tc.blockSize, tc.accounts)
+
+				sequential := newTestMultiStore()
+				genesis(sequential, tc.accounts)
+				for i, tx := range txs {
+					tx.execute(TxnIndex(i), sequential)
+				}
+
+				parallel := newTestMultiStore()
+				genesis(parallel, tc.accounts)
+				err := ExecuteBlock(context.Background(), len(txs), stores, parallel, tc.executors, func(txn TxnIndex, ms MultiStore) {
+					txs[txn].execute(txn, ms)
+				})
+				require.NoError(t, err)
+				require.Equal(t, dump(sequential), dump(parallel), "seed %d", seed)
+			}
+		})
+	}
+}
+
+func TestExecuteBlockCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ms := newTestMultiStore()
+	err := ExecuteBlock(ctx, 10, map[storetypes.StoreKey]int{kvKey: 0, objKey: 1}, ms, 2, func(TxnIndex, MultiStore) {})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestExecuteBlockPanic(t *testing.T) {
+	ms := newTestMultiStore()
+	require.PanicsWithValue(t, "boom", func() {
+		_ = ExecuteBlock(context.Background(), 3, map[storetypes.StoreKey]int{kvKey: 0, objKey: 1}, ms, 2, func(txn TxnIndex, _ MultiStore) {
+			if txn == 1 {
+				panic("boom")
+			}
+		})
+	})
+}
@@ -0,0 +1,284 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package blockstm
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+type kvPair[V any] struct {
+	key   []byte
+	value V
+}
+
+// before returns if a comes before b in the iteration order.
+func before(a, b []byte, ascending bool) bool {
+	if ascending {
+		return bytes.Compare(a, b) < 0
+	}
+	return bytes.Compare(a, b) > 0
+}
+
+var _ storetypes.Iterator = (*mvIterator[[]byte])(nil)
+
+// mvIterator iterates the values visible to a transaction, merging the writes of the
+// lower transactions with the storage. Every raw item consumed, including deletions,
+// is recorded in the descriptor so the iteration can be validated later.
+type mvIterator[V any] struct {
+	view       *GMVView[V]
+	start, end []byte
+	ascending  bool
+	desc       *iteratorDescriptor
+
+	storage storetypes.GIterator[V]
+
+	mvKey   []byte
+	mvEntry mvEntry[V]
+	mvValid bool
+
+	key   []byte
+	value V
+	valid bool
+}
+
+func newMVIterator[V any](view *GMVView[V], start, end []byte, ascending bool, desc *iteratorDescriptor) *mvIterator[V] {
+	it := &mvIterator[V]{
+		view:      view,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+		desc:      desc,
+	}
+	if ascending {
+		it.storage = view.storage.Iterator(start, end)
+	} else {
+		it.storage = view.storage.ReverseIterator(start, end)
+	}
+	it.mvKey, it.mvEntry, it.mvValid = view.mvData.seek(nil, true, start, end, ascending, view.txn)
+	if desc != nil {
+		it.advance()
+	}
+	return it
+}
+
+// nextRaw returns the next item, deletions included, in the merged view of the lower transactions and storage.
+func (it *mvIterator[V]) nextRaw() (key []byte, value V, version TxnVersion, ok bool) {
+	storageValid := it.storage.Valid()
+	if !it.mvValid && !storageValid {
+		return nil, value, version, false
+	}
+
+	useMV := it.mvValid
+	if it.mvValid && storageValid {
+		storageKey := it.storage.Key()
+		switch {
+		case bytes.Equal(it.mvKey, storageKey):
+			// the lower transactions override the storage
+			it.storage.Next()
+		case before(storageKey, it.mvKey, it.ascending):
+			useMV = false
+		}
+	}
+
+	if useMV {
+		key, value, version = it.mvKey, it.mvEntry.value, it.mvEntry.version()
+		it.mvKey, it.mvEntry, it.mvValid = it.view.mvData.seek(key, false, it.start, it.end, it.ascending, it.view.txn)
+		return key, value, version, true
+	}
+
+	key, value, version = bytes.Clone(it.storage.Key()), it.storage.Value(), StorageVersion
+	it.storage.Next()
+	return key, value, version, true
+}
+
+// advance moves to the next non-deleted item, recording the consumed items.
+func (it *mvIterator[V]) advance() {
+	for {
+		key, value, version, ok := it.nextRaw()
+		if !ok {
+			it.desc.exhausted = true
+			it.valid = false
+			return
+		}
+		it.desc.observed = append(it.desc.observed, readDescriptor{key: key, version: version})
+		if it.view.isZero(value) {
+			continue
+		}
+		it.key, it.value, it.valid = key, value, true
+		return
+	}
+}
+
+// Domain implements types.Iterator.
+func (it *mvIterator[V]) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements types.Iterator.
+func (it *mvIterator[V]) Valid() bool {
+	return it.valid
+}
+
+// Next implements types.Iterator.
+func (it *mvIterator[V]) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	it.advance()
+}
+
+// Key implements types.Iterator.
+func (it *mvIterator[V]) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements types.Iterator.
+func (it *mvIterator[V]) Value() V {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements types.Iterator.
+func (it *mvIterator[V]) Error() error {
+	return it.storage.Error()
+}
+
+// Close implements types.Iterator.
+func (it *mvIterator[V]) Close() error {
+	it.valid = false
+	return it.storage.Close()
+}
+
+var _ storetypes.Iterator = (*mergeIterator[[]byte])(nil)
+
+// mergeIterator merges the own writes of a transaction on top of the parent iterator.
+type mergeIterator[V any] struct {
+	parent    storetypes.GIterator[V]
+	cache     []kvPair[V]
+	ascending bool
+	isZero    func(V) bool
+
+	// fromParent is set when the current item is taken from the parent
+	fromParent bool
+	valid      bool
+}
+
+func newMergeIterator[V any](
+	parent storetypes.GIterator[V], cache []kvPair[V], ascending bool, isZero func(V) bool,
+) *mergeIterator[V] {
+	it := &mergeIterator[V]{
+		parent:    parent,
+		cache:     cache,
+		ascending: ascending,
+		isZero:    isZero,
+	}
+	it.skipDeleted()
+	return it
+}
+
+// skipDeleted moves the iterator to the first item that isn't deleted by the own writes.
+func (it *mergeIterator[V]) skipDeleted() {
+	for {
+		parentValid := it.parent.Valid()
+		if len(it.cache) == 0 {
+			it.fromParent, it.valid = true, parentValid
+			return
+		}
+
+		cached := it.cache[0]
+		if parentValid {
+			parentKey := it.parent.Key()
+			if before(parentKey, cached.key, it.ascending) {
+				it.fromParent, it.valid = true, true
+				return
+			}
+			if bytes.Equal(parentKey, cached.key) {
+				// the own write overrides the parent
+				it.parent.Next()
+			}
+		}
+
+		if it.isZero(cached.value) {
+			it.cache = it.cache[1:]
+			continue
+		}
+		it.fromParent, it.valid = false, true
+		return
+	}
+}
+
+// Domain implements types.Iterator.
+func (it *mergeIterator[V]) Domain() ([]byte, []byte) {
+	return it.parent.Domain()
+}
+
+// Valid implements types.Iterator.
+func (it *mergeIterator[V]) Valid() bool {
+	return it.valid
+}
+
+// Next implements types.Iterator.
+func (it *mergeIterator[V]) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	if it.fromParent {
+		it.parent.Next()
+	} else {
+		it.cache = it.cache[1:]
+	}
+	it.skipDeleted()
+}
+
+// Key implements types.Iterator.
+func (it *mergeIterator[V]) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	if it.fromParent {
+		return it.parent.Key()
+	}
+	return it.cache[0].key
+}
+
+// Value implements types.Iterator.
+func (it *mergeIterator[V]) Value() V {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	if it.fromParent {
+		return it.parent.Value()
+	}
+	return it.cache[0].value
+}
+
+// Error implements types.Iterator.
+func (it *mergeIterator[V]) Error() error {
+	return it.parent.Error()
+}
+
+// Close implements types.Iterator.
+func (it *mergeIterator[V]) Close() error {
+	it.valid = false
+	return it.parent.Close()
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package blockstm
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/tidwall/btree"
+)
+
+// mvEntry is a value written to a key by an incarnation of a transaction,
+// a zero value represents a deletion.
+type mvEntry[V any] struct {
+	txn         TxnIndex
+	incarnation Incarnation
+	value       V
+}
+
+func (e mvEntry[V]) version() TxnVersion {
+	return TxnVersion{Index: e.txn, Incarnation: e.incarnation}
+}
+
+// GMVData is the multi-version data structure of a single store, it keeps the values
+// written by every transaction of the block, sorted by key and transaction index.
+type GMVData[V any] struct {
+	mtx  sync.RWMutex
+	data btree.Map[string, []mvEntry[V]]
+
+	isZero func(V) bool
+}
+
+// NewMVData creates the multi-version data of a []byte store.
+func NewMVData() *GMVData[[]byte] {
+	return NewGMVData(func(v []byte) bool { return v == nil })
+}
+
+// NewObjMVData creates the multi-version data of an object store.
+func NewObjMVData() *GMVData[any] {
+	return NewGMVData(func(v any) bool { return v == nil })
+}
+
+// NewGMVData creates the multi-version data of a generic store.
+func NewGMVData[V any](isZero func(V) bool) *GMVData[V] {
+	return &GMVData[V]{isZero: isZero}
+}
+
+// Read returns the value written to the key by the highest transaction below txn.
+func (d *GMVData[V]) Read(key []byte, txn TxnIndex) (value V, version TxnVersion, found bool) {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+
+	entries, ok := d.data.Get(string(key))
+	if !ok {
+		return value, StorageVersion, false
+	}
+	entry, ok := findBelow(entries, txn)
+	if !ok {
+		return value, StorageVersion, false
+	}
+	return entry.value, entry.version(), true
+}
+
+// seek returns the first key of the domain [start, end), after or before the pivot
+// depending on the iteration order, that has been written by a transaction below txn.
+// The pivot itself is included only if inclusive is set, a nil pivot means the
+// beginning of the domain.
+func (d *GMVData[V]) seek(
+	pivot []byte, inclusive bool,
+	start, end []byte, ascending bool,
+	txn TxnIndex,
+) (key []byte, entry mvEntry[V], found bool) {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+
+	iter := func(k string, entries []mvEntry[V]) bool {
+		kb := []byte(k)
+		if ascending {
+			if end != nil && bytes.Compare(kb, end) >= 0 {
+				return false
+			}
+		} else {
+			if start != nil && bytes.Compare(kb, start) < 0 {
+				return false
+			}
+			if end != nil && bytes.Compare(kb, end) >= 0 {
+				// end is exclusive
+				return true
+			}
+		}
+		if pivot != nil && !inclusive && bytes.Equal(kb, pivot) {
+			return true
+		}
+		e, ok := findBelow(entries, txn)
+		if !ok {
+			return true
+		}
+		key, entry, found = kb, e, true
+		return false
+	}
+
+	switch {
+	case ascending && pivot != nil:
+		d.data.Ascend(string(pivot), iter)
+	case ascending && start != nil:
+		d.data.Ascend(string(start), iter)
+	case ascending:
+		d.data.Scan(iter)
+	case pivot != nil:
+		d.data.Descend(string(pivot), iter)
+	case end != nil:
+		d.data.Descend(string(end), iter)
+	default:
+		d.data.Reverse(iter)
+	}
+	return key, entry, found
+}
+
+// Consolidate replaces the writes of the previous incarnation of txn with the new write set.
+func (d *GMVData[V]) Consolidate(version TxnVersion, writes *btree.Map[string, V], previous []string) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	for _, key := range previous {
+		if _, ok := writes.Get(key); ok {
+			continue
+		}
+		d.remove(key, version.Index)
+	}
+
+	writes.Scan(func(key string, value V) bool {
+		d.write(key, mvEntry[V]{txn: version.Index, incarnation: version.Incarnation, value: value})
+		return true
+	})
+}
+
+func (d *GMVData[V]) write(key string, entry mvEntry[V]) {
+	entries, _ := d.data.Get(key)
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].txn >= entry.txn })
+	if i < len(entries) && entries[i].txn == entry.txn {
+		entries[i] = entry
+		return
+	}
+	entries = append(entries, mvEntry[V]{})
+	copy(entries[i+1:], entries[i:])
+	entries[i] = entry
+	d.data.Set(key, entries)
+}
+
+func (d *GMVData[V]) remove(key string, txn TxnIndex) {
+	entries, ok := d.data.Get(key)
+	if !ok {
+		return
+	}
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].txn >= txn })
+	if i == len(entries) || entries[i].txn != txn {
+		return
+	}
+	entries = append(entries[:i], entries[i+1:]...)
+	if len(entries) == 0 {
+		d.data.Delete(key)
+		return
+	}
+	d.data.Set(key, entries)
+}
+
+// WriteSnapshot writes the latest value of every key to the storage.
+func (d *GMVData[V]) WriteSnapshot(storage storetypes.GKVStore[V]) {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+
+	d.data.Scan(func(key string, entries []mvEntry[V]) bool {
+		value := entries[len(entries)-1].value
+		if d.isZero(value) {
+			storage.Delete([]byte(key))
+		} else {
+			storage.Set([]byte(key), value)
+		}
+		return true
+	})
+}
+
+// findBelow returns the entry written by the highest transaction below txn.
+func findBelow[V any](entries []mvEntry[V], txn TxnIndex) (mvEntry[V], bool) {
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].txn >= txn })
+	if i == 0 {
+		return mvEntry[V]{}, false
+	}
+	return entries[i-1], true
+}
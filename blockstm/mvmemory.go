@@ -0,0 +1,177 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package blockstm
+
+import (
+	"fmt"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// MVMemory holds the multi-version data of every store, together with the read and
+// write sets of the last incarnation of every transaction.
+type MVMemory struct {
+	storage MultiStore
+	stores  map[storetypes.StoreKey]int
+	keys    []storetypes.StoreKey
+	data    []any // *GMVData[[]byte] or *GMVData[any], indexed by store index
+
+	// storageMtx serializes the accesses to the storage
+	storageMtx sync.Mutex
+
+	incarnations []Incarnation
+	lastViews    [][]MVView
+	panics       []any
+}
+
+// NewMVMemory creates the multi-version memory for a block of the given size.
+func NewMVMemory(blockSize int, stores map[storetypes.StoreKey]int, storage MultiStore) *MVMemory {
+	mv := &MVMemory{
+		storage:      storage,
+		stores:       stores,
+		keys:         make([]storetypes.StoreKey, len(stores)),
+		data:         make([]any, len(stores)),
+		incarnations: make([]Incarnation, blockSize),
+		lastViews:    make([][]MVView, blockSize),
+		panics:       make([]any, blockSize),
+	}
+	for key, i := range stores {
+		mv.keys[i] = key
+		if isObjStore(key) {
+			mv.data[i] = NewObjMVData()
+		} else {
+			mv.data[i] = NewMVData()
+		}
+	}
+	return mv
+}
+
+// newView creates the view of a store for an incarnation of txn.
+func (mv *MVMemory) newView(txn TxnIndex, store int) MVView {
+	key := mv.keys[store]
+	switch data := mv.data[store].(type) {
+	case *GMVData[any]:
+		storage := lockedStore[any]{GKVStore: mv.storage.GetObjKVStore(key), mtx: &mv.storageMtx}
+		return NewObjMVView(txn, data, storage)
+	case *GMVData[[]byte]:
+		storage := lockedStore[[]byte]{GKVStore: mv.storage.GetKVStore(key), mtx: &mv.storageMtx}
+		return NewMVView(txn, data, storage)
+	default:
+		panic(fmt.Sprintf("unknown multi-version data type %T", data))
+	}
+}
+
+// Record publishes the write sets of an incarnation of txn and keeps its read sets for validation.
+func (mv *MVMemory) Record(txn TxnIndex, views []MVView, recovered any) {
+	incarnation := mv.incarnations[txn]
+	previous := mv.lastViews[txn]
+	for i, view := range views {
+		var prev MVView
+		if previous != nil {
+			prev = previous[i]
+		}
+		switch {
+		case view != nil:
+			view.ApplyWriteSet(incarnation, prev)
+		case prev != nil:
+			// the store is not touched anymore, clear the previous writes
+			mv.newView(txn, i).ApplyWriteSet(incarnation, prev)
+		}
+	}
+	mv.lastViews[txn] = views
+	mv.panics[txn] = recovered
+	mv.incarnations[txn]++
+}
+
+// ValidateReadSet checks the read sets of the last incarnation of txn against the current multi-version data.
+func (mv *MVMemory) ValidateReadSet(txn TxnIndex) bool {
+	for _, view := range mv.lastViews[txn] {
+		if view != nil && !view.ValidateReadSet() {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteSnapshot writes the final values of the block to the storage.
+func (mv *MVMemory) WriteSnapshot(storage MultiStore) {
+	for i, key := range mv.keys {
+		switch data := mv.data[i].(type) {
+		case *GMVData[any]:
+			data.WriteSnapshot(storage.GetObjKVStore(key))
+		case *GMVData[[]byte]:
+			data.WriteSnapshot(storage.GetKVStore(key))
+		}
+	}
+}
+
+func isObjStore(key storetypes.StoreKey) bool {
+	_, ok := key.(*storetypes.ObjectStoreKey)
+	return ok
+}
+
+var _ MultiStore = (*multiMVView)(nil)
+
+// multiMVView is the multistore seen by an incarnation of a transaction,
+// the store views are created lazily.
+type multiMVView struct {
+	txn   TxnIndex
+	mv    *MVMemory
+	views []MVView
+}
+
+func newMultiMVView(txn TxnIndex, mv *MVMemory) *multiMVView {
+	return &multiMVView{
+		txn:   txn,
+		mv:    mv,
+		views: make([]MVView, len(mv.keys)),
+	}
+}
+
+func (s *multiMVView) getView(key storetypes.StoreKey) MVView {
+	i, ok := s.mv.stores[key]
+	if !ok {
+		panic(fmt.Sprintf("store %s is not registered in block-stm", key.Name()))
+	}
+	if s.views[i] == nil {
+		s.views[i] = s.mv.newView(s.txn, i)
+	}
+	return s.views[i]
+}
+
+// GetStore implements MultiStore.
+func (s *multiMVView) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return s.getView(key)
+}
+
+// GetKVStore implements MultiStore.
+func (s *multiMVView) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	view, ok := s.getView(key).(storetypes.KVStore)
+	if !ok {
+		panic(fmt.Sprintf("store %s is not KVStore", key.Name()))
+	}
+	return view
+}
+
+// GetObjKVStore implements MultiStore.
+func (s *multiMVView) GetObjKVStore(key storetypes.StoreKey) storetypes.ObjKVStore {
+	view, ok := s.getView(key).(storetypes.ObjKVStore)
+	if !ok {
+		panic(fmt.Sprintf("store %s is not ObjKVStore", key.Name()))
+	}
+	return view
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package blockstm
+
+import (
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// lockedStore serializes the accesses to the pre-block storage, which is shared by all the
+// executors but isn't safe for concurrent use. It's read-only during the block execution.
+type lockedStore[V any] struct {
+	storetypes.GKVStore[V]
+	mtx *sync.Mutex
+}
+
+func (s lockedStore[V]) Get(key []byte) V {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.GKVStore.Get(key)
+}
+
+func (s lockedStore[V]) Has(key []byte) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.GKVStore.Has(key)
+}
+
+func (s lockedStore[V]) Set([]byte, V) {
+	panic("storage is read-only during block execution")
+}
+
+func (s lockedStore[V]) Delete([]byte) {
+	panic("storage is read-only during block execution")
+}
+
+func (s lockedStore[V]) Iterator(start, end []byte) storetypes.GIterator[V] {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return lockedIterator[V]{GIterator: s.GKVStore.Iterator(start, end), mtx: s.mtx}
+}
+
+func (s lockedStore[V]) ReverseIterator(start, end []byte) storetypes.GIterator[V] {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return lockedIterator[V]{GIterator: s.GKVStore.ReverseIterator(start, end), mtx: s.mtx}
+}
+
+type lockedIterator[V any] struct {
+	storetypes.GIterator[V]
+	mtx *sync.Mutex
+}
+
+func (it lockedIterator[V]) Valid() bool {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.GIterator.Valid()
+}
+
+func (it lockedIterator[V]) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	it.GIterator.Next()
+}
+
+func (it lockedIterator[V]) Key() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.GIterator.Key()
+}
+
+func (it lockedIterator[V]) Value() V {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.GIterator.Value()
+}
+
+func (it lockedIterator[V]) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.GIterator.Error()
+}
+
+func (it lockedIterator[V]) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.GIterator.Close()
+}
@@ -0,0 +1,46 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package blockstm
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// TxnIndex is the position of a transaction in the block.
+type TxnIndex int
+
+// Incarnation counts the executions of a single transaction.
+type Incarnation uint
+
+// TxnVersion identifies a value written by a specific execution of a transaction.
+type TxnVersion struct {
+	Index       TxnIndex
+	Incarnation Incarnation
+}
+
+// StorageVersion is the version of the values read from the pre-block storage.
+var StorageVersion = TxnVersion{Index: -1}
+
+// MultiStore is the subset of the cosmos-sdk multistore the transactions are executed against.
+type MultiStore interface {
+	GetStore(storetypes.StoreKey) storetypes.Store
+	GetKVStore(storetypes.StoreKey) storetypes.KVStore
+	GetObjKVStore(storetypes.StoreKey) storetypes.ObjKVStore
+}
+
+// TxExecutor executes the transaction at the given index against the given multistore,
+// it's called once for every incarnation of the transaction.
+type TxExecutor func(TxnIndex, MultiStore)
@@ -0,0 +1,237 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package blockstm
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/tidwall/btree"
+)
+
+// MVView is the view of a single store seen by an incarnation of a transaction.
+type MVView interface {
+	storetypes.Store
+
+	// ApplyWriteSet publishes the writes of the view to the multi-version data,
+	// replacing the ones of the previous incarnation of the same transaction.
+	ApplyWriteSet(incarnation Incarnation, previous MVView)
+	// ValidateReadSet checks that the values read by the view are still the latest
+	// ones written by the lower transactions.
+	ValidateReadSet() bool
+
+	writtenKeys() []string
+}
+
+// readDescriptor records the version of a value read from the multi-version data or the storage.
+type readDescriptor struct {
+	key     []byte
+	version TxnVersion
+}
+
+// iteratorDescriptor records the keys observed by an iterator, so the iteration can be
+// replayed to detect the keys inserted or removed by the lower transactions.
+type iteratorDescriptor struct {
+	start, end []byte
+	ascending  bool
+	observed   []readDescriptor
+	exhausted  bool
+}
+
+var _ storetypes.KVStore = (*GMVView[[]byte])(nil)
+var _ storetypes.ObjKVStore = (*GMVView[any])(nil)
+
+// GMVView implements the store interface on top of the multi-version data and the storage,
+// it buffers the writes of the transaction and records every read for validation.
+type GMVView[V any] struct {
+	txn     TxnIndex
+	mvData  *GMVData[V]
+	storage storetypes.GKVStore[V]
+
+	writes    btree.Map[string, V]
+	reads     []readDescriptor
+	iterators []*iteratorDescriptor
+
+	isZero   func(V) bool
+	valueLen func(V) int
+}
+
+// NewMVView creates the view of a []byte store for a transaction.
+func NewMVView(txn TxnIndex, mvData *GMVData[[]byte], storage storetypes.KVStore) *GMVView[[]byte] {
+	return NewGMVView(txn, mvData, storage, func(v []byte) bool { return v == nil }, func(v []byte) int { return len(v) })
+}
+
+// NewObjMVView creates the view of an object store for a transaction.
+func NewObjMVView(txn TxnIndex, mvData *GMVData[any], storage storetypes.ObjKVStore) *GMVView[any] {
+	return NewGMVView(txn, mvData, storage, func(v any) bool { return v == nil }, func(any) int { return 1 })
+}
+
+// NewGMVView creates the view of a generic store for a transaction.
+func NewGMVView[V any](
+	txn TxnIndex,
+	mvData *GMVData[V],
+	storage storetypes.GKVStore[V],
+	isZero func(V) bool,
+	valueLen func(V) int,
+) *GMVView[V] {
+	return &GMVView[V]{
+		txn:      txn,
+		mvData:   mvData,
+		storage:  storage,
+		isZero:   isZero,
+		valueLen: valueLen,
+	}
+}
+
+// GetStoreType implements types.Store.
+func (s *GMVView[V]) GetStoreType() storetypes.StoreType {
+	return s.storage.GetStoreType()
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (s *GMVView[V]) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewGStore[V](s, s.isZero, s.valueLen)
+}
+
+// Get implements types.KVStore.
+func (s *GMVView[V]) Get(key []byte) V {
+	storetypes.AssertValidKey(key)
+
+	if value, ok := s.writes.Get(string(key)); ok {
+		return value
+	}
+	return s.read(key)
+}
+
+// Has implements types.KVStore.
+func (s *GMVView[V]) Has(key []byte) bool {
+	return !s.isZero(s.Get(key))
+}
+
+// Set implements types.KVStore.
+func (s *GMVView[V]) Set(key []byte, value V) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValueGeneric(value, s.isZero, s.valueLen)
+
+	s.writes.Set(string(key), value)
+}
+
+// Delete implements types.KVStore.
+func (s *GMVView[V]) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+
+	var zero V
+	s.writes.Set(string(key), zero)
+}
+
+// Iterator implements types.KVStore.
+func (s *GMVView[V]) Iterator(start, end []byte) storetypes.GIterator[V] {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements types.KVStore.
+func (s *GMVView[V]) ReverseIterator(start, end []byte) storetypes.GIterator[V] {
+	return s.iterator(start, end, false)
+}
+
+func (s *GMVView[V]) iterator(start, end []byte, ascending bool) storetypes.GIterator[V] {
+	desc := &iteratorDescriptor{start: start, end: end, ascending: ascending}
+	s.iterators = append(s.iterators, desc)
+	parent := newMVIterator(s, start, end, ascending, desc)
+	return newMergeIterator(parent, s.snapshotWrites(start, end, ascending), ascending, s.isZero)
+}
+
+// read reads the key from the lower transactions, falling back to the storage.
+func (s *GMVView[V]) read(key []byte) V {
+	value, version, found := s.mvData.Read(key, s.txn)
+	if !found {
+		value = s.storage.Get(key)
+	}
+	s.reads = append(s.reads, readDescriptor{key: key, version: version})
+	return value
+}
+
+// snapshotWrites returns the sorted own writes in the domain.
+func (s *GMVView[V]) snapshotWrites(start, end []byte, ascending bool) []kvPair[V] {
+	var pairs []kvPair[V]
+	collect := func(k string, v V) bool {
+		key := []byte(k)
+		if end != nil && bytes.Compare(key, end) >= 0 {
+			return false
+		}
+		pairs = append(pairs, kvPair[V]{key: key, value: v})
+		return true
+	}
+	if start != nil {
+		s.writes.Ascend(string(start), collect)
+	} else {
+		s.writes.Scan(collect)
+	}
+	if !ascending {
+		for i, j := 0, len(pairs)-1; i < j; i, j = i+1, j-1 {
+			pairs[i], pairs[j] = pairs[j], pairs[i]
+		}
+	}
+	return pairs
+}
+
+// ApplyWriteSet implements MVView.
+func (s *GMVView[V]) ApplyWriteSet(incarnation Incarnation, previous MVView) {
+	var prevKeys []string
+	if previous != nil {
+		prevKeys = previous.writtenKeys()
+	}
+	s.mvData.Consolidate(TxnVersion{Index: s.txn, Incarnation: incarnation}, &s.writes, prevKeys)
+}
+
+// ValidateReadSet implements MVView.
+func (s *GMVView[V]) ValidateReadSet() bool {
+	for _, read := range s.reads {
+		_, version, _ := s.mvData.Read(read.key, s.txn)
+		if version != read.version {
+			return false
+		}
+	}
+
+	for _, desc := range s.iterators {
+		it := newMVIterator(s, desc.start, desc.end, desc.ascending, nil)
+		for _, read := range desc.observed {
+			key, _, version, ok := it.nextRaw()
+			if !ok || version != read.version || !bytes.Equal(key, read.key) {
+				it.Close()
+				return false
+			}
+		}
+		if desc.exhausted {
+			if _, _, _, ok := it.nextRaw(); ok {
+				it.Close()
+				return false
+			}
+		}
+		it.Close()
+	}
+	return true
+}
+
+func (s *GMVView[V]) writtenKeys() []string {
+	keys := make([]string, 0, s.writes.Len())
+	s.writes.Scan(func(key string, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
@@ -0,0 +1,171 @@
+package blockstm
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/dbadapter"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorage(t *testing.T, kvs ...string) dbadapter.Store {
+	db := dbm.NewMemDB()
+	for i := 0; i < len(kvs); i += 2 {
+		require.NoError(t, db.Set([]byte(kvs[i]), []byte(kvs[i+1])))
+	}
+	return dbadapter.Store{DB: db}
+}
+
+// publish records a write set for the transaction in the multi-version data.
+func publish(data *GMVData[[]byte], storage dbadapter.Store, txn TxnIndex, incarnation Incarnation, previous MVView, kvs ...string) MVView {
+	view := NewMVView(txn, data, storage)
+	for i := 0; i < len(kvs); i += 2 {
+		if kvs[i+1] == "" {
+			view.Delete([]byte(kvs[i]))
+		} else {
+			view.Set([]byte(kvs[i]), []byte(kvs[i+1]))
+		}
+	}
+	view.ApplyWriteSet(incarnation, previous)
+	return view
+}
+
+func collect(view *GMVView[[]byte], ascending bool) []string {
+	var result []string
+	var it = view.Iterator(nil, nil)
+	if !ascending {
+		it = view.ReverseIterator(nil, nil)
+	}
+	for ; it.Valid(); it.Next() {
+		result = append(result, string(it.Key())+"="+string(it.Value()))
+	}
+	it.Close()
+	return result
+}
+
+func TestMVViewRead(t *testing.T) {
+	storage := newTestStorage(t, "a", "0", "b", "0")
+	data := NewMVData()
+
+	publish(data, storage, 0, 0, nil, "a", "1")
+	publish(data, storage, 2, 0, nil, "a", "3")
+
+	view := NewMVView(1, data, storage)
+	require.Equal(t, []byte("1"), view.Get([]byte("a")))
+	require.Equal(t, []byte("0"), view.Get([]byte("b")))
+	require.False(t, view.Has([]byte("c")))
+
+	// own writes take precedence
+	view.Set([]byte("b"), []byte("x"))
+	require.Equal(t, []byte("x"), view.Get([]byte("b")))
+	view.Delete([]byte("a"))
+	require.Nil(t, view.Get([]byte("a")))
+
+	require.True(t, view.ValidateReadSet())
+}
+
+func TestMVViewValidateReadSet(t *testing.T) {
+	testCases := []struct {
+		name   string
+		read   func(view *GMVView[[]byte])
+		writes []string
+		valid  bool
+	}{
+		{
+			"write to a key that was read",
+			func(view *GMVView[[]byte]) { view.Get([]byte("a")) },
+			[]string{"a", "1"},
+			false,
+		},
+		{
+			"write to a key that was not read",
+			func(view *GMVView[[]byte]) { view.Get([]byte("a")) },
+			[]string{"b", "1"},
+			true,
+		},
+		{
+			"delete a key that was checked",
+			func(view *GMVView[[]byte]) { view.Has([]byte("b")) },
+			[]string{"b", ""},
+			false,
+		},
+		{
+			"insert a key into an iterated range",
+			func(view *GMVView[[]byte]) { collect(view, true) },
+			[]string{"ab", "1"},
+			false,
+		},
+		{
+			"delete a key from a reverse iterated range",
+			func(view *GMVView[[]byte]) { collect(view, false) },
+			[]string{"a", ""},
+			false,
+		},
+		{
+			"insert a key after a partial iteration",
+			func(view *GMVView[[]byte]) {
+				it := view.Iterator(nil, nil)
+				it.Close()
+			},
+			[]string{"c", "1"},
+			true,
+		},
+		{
+			"insert a key outside the iterated domain",
+			func(view *GMVView[[]byte]) {
+				it := view.Iterator([]byte("a"), []byte("b"))
+				for ; it.Valid(); it.Next() {
+				}
+				it.Close()
+			},
+			[]string{"c", "1"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storage := newTestStorage(t, "a", "0", "b", "0")
+			data := NewMVData()
+			prev := publish(data, storage, 0, 0, nil)
+
+			view := NewMVView(1, data, storage)
+			tc.read(view)
+			require.True(t, view.ValidateReadSet())
+
+			prev = publish(data, storage, 0, 1, prev, tc.writes...)
+			require.Equal(t, tc.valid, view.ValidateReadSet())
+
+			// an incarnation without writes makes the reads of the storage valid again
+			publish(data, storage, 0, 2, prev)
+			require.True(t, view.ValidateReadSet())
+		})
+	}
+}
+
+func TestMVViewIterator(t *testing.T) {
+	storage := newTestStorage(t, "a", "0", "c", "0", "e", "0")
+	data := NewMVData()
+
+	publish(data, storage, 0, 0, nil, "b", "1", "c", "")
+	publish(data, storage, 1, 0, nil, "d", "2", "e", "2")
+	// not visible to txn 2
+	publish(data, storage, 3, 0, nil, "f", "3")
+
+	view := NewMVView(2, data, storage)
+	view.Set([]byte("a"), []byte("x"))
+	view.Delete([]byte("d"))
+	view.Set([]byte("g"), []byte("x"))
+
+	require.Equal(t, []string{"a=x", "b=1", "e=2", "g=x"}, collect(view, true))
+	require.Equal(t, []string{"g=x", "e=2", "b=1", "a=x"}, collect(view, false))
+
+	it := view.Iterator([]byte("b"), []byte("e"))
+	require.True(t, it.Valid())
+	require.Equal(t, []byte("b"), it.Key())
+	it.Next()
+	require.False(t, it.Valid())
+	it.Close()
+
+	require.True(t, view.ValidateReadSet())
+}
@@ -17,6 +17,8 @@ package indexer
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -31,17 +33,27 @@ import (
 	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
 
 	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
-	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixLogAddress = 3
+	KeyPrefixLogTopic   = 4
+	KeyPrefixLogRange   = 5
+	KeyPrefixGap        = 6
+	KeyPrefixLogHeight  = 7
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+
+	// PruneBatchSize is the number of entries scanned per db batch when pruning
+	PruneBatchSize = 10000
 )
 
+// LogRangeKey is the key of the block range covered by the log index
+var LogRangeKey = []byte{KeyPrefixLogRange}
+
 var _ ethermint.EVMTxIndexer = &KVIndexer{}
 
 // KVIndexer implements a eth tx indexer on a KV db.
@@ -49,99 +61,71 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	// fullReceipts defines if the full receipts are stored in the tx results
+	fullReceipts bool
+
+	// mtx serializes the updates of the metadata entries, which are read and written back
+	mtx sync.Mutex
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
 }
 
-// IndexBlock index all the eth txs in a block through the following steps:
-// - Iterates over all of the Txs in Block
-// - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
-// - Builds and stores a indexer.TxResult based on parsed events for every message
-func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxResult) (err error) {
-	defer func(err *error) {
-		if e := recover(); e != nil {
-			kv.logger.Debug("panic during parsing block results", "error", e)
-
-			if ee, ok := e.(error); ok {
-				*err = ee
-			} else {
-				*err = fmt.Errorf("panic during parsing block results: %v", e)
-			}
-		}
-	}(&err)
+// SetFullReceipts configures the indexer to store the full receipts of the eth txs, so they can be served after
+// the block results are pruned.
+func (kv *KVIndexer) SetFullReceipts(enabled bool) {
+	kv.fullReceipts = enabled
+}
 
+// IndexBlock index all the eth txs in a block, it stores a indexer.TxResult for every eth tx and indexes the
+// block height by the address and by the topics of the emitted logs.
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxResult, finalizeEvents []abci.Event) error {
 	kv.logger.Debug("(KVIndexer) IndexBlock", "height", block.Height, "txns:", len(block.Txs))
 
+	txs, err := parseBlock(kv.clientCtx, kv.logger, block, txResults)
+	if err != nil {
+		return err
+	}
+	if kv.fullReceipts {
+		signer := blockSigner(kv.logger, block)
+		baseFee := rpctypes.BaseFeeFromEvents(finalizeEvents)
+		for i := range txs {
+			txs[i].result.Receipt = newTxReceipt(block, &txs[i], signer, baseFee)
+		}
+	}
+
+	kv.mtx.Lock()
+	defer kv.mtx.Unlock()
+
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", block.Height, "txIndex", txIndex)
-			continue
+	// the log keys only record the height, deduplicate them within the block
+	logKeys := make(map[string]struct{})
+	for i := range txs {
+		for _, txLog := range txs[i].logs {
+			logKeys[string(LogAddressKey(txLog.Address, block.Height))] = struct{}{}
+			for j, topic := range txLog.Topics {
+				logKeys[string(LogTopicKey(j, topic, block.Height))] = struct{}{}
+			}
 		}
-
-		if !isEthTx(tx) {
-			continue
+		if err := saveTxResult(kv.clientCtx.Codec, batch, txs[i].hash, &txs[i].result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
 		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", block.Height, "txIndex", txIndex)
-			continue
+	}
+	for key := range logKeys {
+		if err := batch.Set([]byte(key), []byte{}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log key", block.Height)
 		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			var txHash common.Hash
-
-			txResult := ethermint.TxResult{
-				Height:     block.Height,
-				TxIndex:    uint32(txIndex),
-				MsgIndex:   uint32(msgIndex),
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK && result.Codespace != evmtypes.ModuleName {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-				txHash = ethMsg.Hash()
-			} else {
-				// success or fail due to VM error
-
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in results", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-				txHash = parsedTx.Hash
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
-			}
+		if err := batch.Set(LogHeightKey(block.Height, []byte(key)), []byte{}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log height key", block.Height)
 		}
 	}
+	if err := kv.extendLogRange(batch, block.Height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -186,6 +170,317 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethe
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// FilterLogHeights returns the heights within [from, to] which contain logs emitted by one of the addresses and
+// matching the topics by position, an empty list matches any address or topic. The heights not covered by the log
+// index are returned as candidates, so the caller must still filter the logs of the returned blocks.
+func (kv *KVIndexer) FilterLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error) {
+	first, last, err := kv.loadLogRange()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "FilterLogHeights")
+	}
+	lo, hi := max(from, first), min(to, last)
+
+	var matched map[int64]struct{}
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+		}
+		if matched, err = kv.logHeights(prefixes, lo, hi); err != nil {
+			return nil, err
+		}
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		prefixes := make([][]byte, len(sub))
+		for j, topic := range sub {
+			prefixes[j] = logTopicPrefix(i, topic)
+		}
+		heights, err := kv.logHeights(prefixes, lo, hi)
+		if err != nil {
+			return nil, err
+		}
+		matched = intersectHeights(matched, heights)
+	}
+	if matched == nil {
+		return candidateHeights(from, to, lo, hi, nil), nil
+	}
+
+	result := make([]int64, 0, len(matched))
+	for height := range matched {
+		result = append(result, height)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return candidateHeights(from, to, lo, hi, result), nil
+}
+
+// PruneBlocks removes the indexed txs, the log keys and the gaps of the blocks below retainHeight, the first
+// indexed block advances accordingly.
+func (kv *KVIndexer) PruneBlocks(retainHeight int64) error {
+	if retainHeight <= 0 {
+		return nil
+	}
+	// shrink the log range first, so the pruned heights are never filtered out by the log index
+	if err := kv.pruneMetadata(retainHeight); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d", retainHeight)
+	}
+
+	end := append([]byte{KeyPrefixTxIndex}, sdk.Uint64ToBigEndian(uint64(retainHeight))...)
+	if err := kv.pruneKeys([]byte{KeyPrefixTxIndex}, end, func(key, value []byte) [][]byte {
+		return [][]byte{append([]byte{}, key...), TxHashKey(common.BytesToHash(value))}
+	}); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, prune txs", retainHeight)
+	}
+
+	// the log keys are ordered by address or topic first, they are found by the log height keys
+	end = append([]byte{KeyPrefixLogHeight}, sdk.Uint64ToBigEndian(uint64(retainHeight))...)
+	if err := kv.pruneKeys([]byte{KeyPrefixLogHeight}, end, func(key, _ []byte) [][]byte {
+		return [][]byte{append([]byte{}, key...), append([]byte{}, key[9:]...)}
+	}); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, prune logs", retainHeight)
+	}
+	return nil
+}
+
+// pruneMetadata moves the start of the log range and of the gaps to retainHeight
+func (kv *KVIndexer) pruneMetadata(retainHeight int64) error {
+	kv.mtx.Lock()
+	defer kv.mtx.Unlock()
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	first, last, err := kv.loadLogRange()
+	if err != nil {
+		return err
+	}
+	switch {
+	case first > last || first >= retainHeight:
+	case last < retainHeight:
+		if err := batch.Delete(LogRangeKey); err != nil {
+			return errorsmod.Wrap(err, "delete log range key")
+		}
+	default:
+		bz := append(sdk.Uint64ToBigEndian(uint64(retainHeight)), sdk.Uint64ToBigEndian(uint64(last))...)
+		if err := batch.Set(LogRangeKey, bz); err != nil {
+			return errorsmod.Wrap(err, "set log range key")
+		}
+	}
+
+	gaps, err := kv.Gaps()
+	if err != nil {
+		return err
+	}
+	for _, gap := range gaps {
+		if gap.From >= retainHeight {
+			break
+		}
+		if err := batch.Delete(GapKey(gap.From)); err != nil {
+			return errorsmod.Wrap(err, "delete gap key")
+		}
+		if gap.To >= retainHeight {
+			if err := batch.Set(GapKey(retainHeight), sdk.Uint64ToBigEndian(uint64(gap.To))); err != nil {
+				return errorsmod.Wrap(err, "set gap key")
+			}
+		}
+	}
+	return batch.Write()
+}
+
+// pruneKeys deletes the keys returned by the filter for the entries within [start, end), the entries are scanned
+// in chunks of PruneBatchSize and the iterator is closed before every batch write.
+func (kv *KVIndexer) pruneKeys(start, end []byte, filter func(key, value []byte) [][]byte) error {
+	for start != nil {
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return err
+		}
+		var keys [][]byte
+		start = nil
+		for n := 0; it.Valid(); it.Next() {
+			if n == PruneBatchSize {
+				start = append([]byte{}, it.Key()...)
+				break
+			}
+			keys = append(keys, filter(it.Key(), it.Value())...)
+			n++
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			continue
+		}
+
+		batch := kv.db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordGap records the block range [from, to] which is not indexed, it's merged with the overlapping and the
+// adjacent gaps already recorded.
+func (kv *KVIndexer) RecordGap(from, to int64) error {
+	if from > to {
+		return nil
+	}
+	kv.mtx.Lock()
+	defer kv.mtx.Unlock()
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	// the gaps don't overlap, iterate backward from the last one starting within the range
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixGap}, GapKey(to+2))
+	if err != nil {
+		return errorsmod.Wrap(err, "RecordGap")
+	}
+	for ; it.Valid(); it.Next() {
+		gapFrom := int64(sdk.BigEndianToUint64(it.Key()[1:]))
+		gapTo := int64(sdk.BigEndianToUint64(it.Value()))
+		if gapTo < from-1 {
+			break
+		}
+		from, to = min(from, gapFrom), max(to, gapTo)
+		if err := batch.Delete(GapKey(gapFrom)); err != nil {
+			it.Close()
+			return errorsmod.Wrap(err, "RecordGap, delete gap key")
+		}
+	}
+	if err := it.Close(); err != nil {
+		return errorsmod.Wrap(err, "RecordGap")
+	}
+	if err := batch.Set(GapKey(from), sdk.Uint64ToBigEndian(uint64(to))); err != nil {
+		return errorsmod.Wrap(err, "RecordGap, set gap key")
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrap(err, "RecordGap, write batch")
+	}
+	return nil
+}
+
+// Gaps returns the recorded block ranges which are not indexed, in ascending order.
+func (kv *KVIndexer) Gaps() ([]ethermint.IndexerGap, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixGap}, []byte{KeyPrefixGap + 1})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "Gaps")
+	}
+	defer it.Close()
+
+	gaps := []ethermint.IndexerGap{}
+	for ; it.Valid(); it.Next() {
+		gaps = append(gaps, ethermint.IndexerGap{
+			From: int64(sdk.BigEndianToUint64(it.Key()[1:])),
+			To:   int64(sdk.BigEndianToUint64(it.Value())),
+		})
+	}
+	return gaps, nil
+}
+
+// candidateHeights returns the heights within [from, to] which are either matched by the log index covering
+// [lo, hi] or not covered by it, a nil matched list means nothing is filtered by the index.
+func candidateHeights(from, to, lo, hi int64, matched []int64) []int64 {
+	if matched == nil || lo > hi {
+		lo, hi = to+1, to
+	}
+
+	heights := make([]int64, 0, len(matched))
+	for height := from; height <= to && height < lo; height++ {
+		heights = append(heights, height)
+	}
+	heights = append(heights, matched...)
+	for height := max(from, hi+1); height <= to; height++ {
+		heights = append(heights, height)
+	}
+	return heights
+}
+
+// logHeights returns the union of the heights within [from, to] indexed under the log key prefixes
+func (kv *KVIndexer) logHeights(prefixes [][]byte, from, to int64) (map[int64]struct{}, error) {
+	heights := make(map[int64]struct{})
+	if from > to {
+		return heights, nil
+	}
+	for _, prefix := range prefixes {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...)
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to)+1)...)
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "iterate log keys")
+		}
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			heights[int64(sdk.BigEndianToUint64(key[len(key)-8:]))] = struct{}{}
+		}
+		if err := it.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return heights, nil
+}
+
+// intersectHeights returns the heights contained in both sets, a nil set contains every height
+func intersectHeights(a, b map[int64]struct{}) map[int64]struct{} {
+	if a == nil {
+		return b
+	}
+	result := make(map[int64]struct{})
+	for height := range a {
+		if _, ok := b[height]; ok {
+			result[height] = struct{}{}
+		}
+	}
+	return result
+}
+
+// loadLogRange returns the block range covered by the log index, the range is empty if the logs are not indexed.
+func (kv *KVIndexer) loadLogRange() (int64, int64, error) {
+	bz, err := kv.db.Get(LogRangeKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(bz) != 16 {
+		return 0, -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:])), nil
+}
+
+// extendLogRange records the indexed height in the block range covered by the log index, the blocks are indexed
+// contiguously either forward or backward, a block not adjacent to the range starts a new one.
+func (kv *KVIndexer) extendLogRange(batch dbm.Batch, height int64) error {
+	first, last, err := kv.loadLogRange()
+	if err != nil {
+		return err
+	}
+	switch {
+	case first > last || height < first-1 || height > last+1:
+		first, last = height, height
+	case height < first:
+		first = height
+	case height > last:
+		last = height
+	default:
+		return nil
+	}
+	bz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)
+	if err := batch.Set(LogRangeKey, bz); err != nil {
+		return errorsmod.Wrap(err, "set log range key")
+	}
+	return nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -198,6 +493,33 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// LogAddressKey returns the key for db entry: `(log address, block number) -> empty`
+func LogAddressKey(address common.Address, blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	return append(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), bz...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, log topic, block number) -> empty`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64) []byte {
+	return append(logTopicPrefix(position, topic), sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// LogHeightKey returns the key for db entry: `(block number, log address or topic key) -> empty`, it indexes the
+// log keys by height for pruning.
+func LogHeightKey(blockNumber int64, logKey []byte) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	return append(append([]byte{KeyPrefixLogHeight}, bz...), logKey...)
+}
+
+// GapKey returns the key for db entry: `gap start block number -> gap end block number`
+func GapKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixGap}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -1,6 +1,8 @@
 package indexer_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -11,11 +13,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/Helios-Chain-Labs/ethermint/crypto/ethsecp256k1"
 	"github.com/Helios-Chain-Labs/ethermint/indexer"
 	"github.com/Helios-Chain-Labs/ethermint/tests"
 	"github.com/Helios-Chain-Labs/ethermint/testutil/config"
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+	feemarkettypes "github.com/Helios-Chain-Labs/ethermint/x/feemarket/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -141,7 +146,7 @@ func TestKVIndexer(t *testing.T) {
 			db := dbm.NewMemDB()
 			idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
 
-			err = idxer.IndexBlock(tc.block, tc.blockResult)
+			err = idxer.IndexBlock(tc.block, tc.blockResult, nil)
 			require.NoError(t, err)
 			if !tc.expSuccess {
 				first, err := idxer.FirstIndexedBlock()
@@ -170,3 +175,316 @@ func TestKVIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(
+		nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+	)
+	tx.From = common.BytesToAddress(priv.PubKey().Address().Bytes()).Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := config.MakeConfigForTest(nil)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), types.DefaultEVMDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	addrA := common.BigToAddress(big.NewInt(10))
+	addrB := common.BigToAddress(big.NewInt(11))
+	topic0 := common.BigToHash(big.NewInt(20))
+	topic1 := common.BigToHash(big.NewInt(21))
+
+	block := func(height int64) *tmtypes.Block {
+		return &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	}
+	result := func(logs ...*ethtypes.Log) []*abci.ExecTxResult {
+		events := []abci.Event{
+			{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+			}},
+		}
+		for _, log := range logs {
+			bz, err := json.Marshal(types.NewLogFromEth(log))
+			require.NoError(t, err)
+			events = append(events, abci.Event{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyTxLog, Value: string(bz)},
+			}})
+		}
+		return []*abci.ExecTxResult{{Code: 0, Events: events}}
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	// the logs are not indexed yet, every height is a candidate
+	heights, err := idxer.FilterLogHeights(1, 3, []common.Address{addrA}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, heights)
+
+	require.NoError(t, idxer.IndexBlock(block(1), result(), nil))
+	require.NoError(t, idxer.IndexBlock(block(2), result(&ethtypes.Log{Address: addrA, Topics: []common.Hash{topic0}}), nil))
+	require.NoError(t, idxer.IndexBlock(block(3), result(), nil))
+	require.NoError(t, idxer.IndexBlock(block(4), result(
+		&ethtypes.Log{Address: addrB, Topics: []common.Hash{topic0, topic1}},
+		&ethtypes.Log{Address: addrB},
+	), nil))
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expHeight []int64
+	}{
+		{"no criteria", 1, 4, nil, nil, []int64{1, 2, 3, 4}},
+		{"address", 1, 4, []common.Address{addrA}, nil, []int64{2}},
+		{"addresses", 1, 4, []common.Address{addrA, addrB}, nil, []int64{2, 4}},
+		{"first topic", 1, 4, nil, [][]common.Hash{{topic0}}, []int64{2, 4}},
+		{"topic by position", 1, 4, nil, [][]common.Hash{{topic1}}, []int64{}},
+		{"wildcard topic", 1, 4, nil, [][]common.Hash{nil, {topic1}}, []int64{4}},
+		{"address and topic", 1, 4, []common.Address{addrA}, [][]common.Hash{nil, {topic1}}, []int64{}},
+		{"sub range", 3, 4, nil, [][]common.Hash{{topic0}}, []int64{4}},
+		{"not indexed heights", 1, 6, []common.Address{addrA}, nil, []int64{2, 5, 6}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			heights, err := idxer.FilterLogHeights(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeight, heights)
+		})
+	}
+}
+
+func TestKVIndexerPrune(t *testing.T) {
+	encodingConfig := config.MakeConfigForTest(nil)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	testIndexerPrune(t, clientCtx, indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx))
+
+	// the log keys are pruned together with their height keys
+	for _, prefix := range []byte{indexer.KeyPrefixLogAddress, indexer.KeyPrefixLogTopic, indexer.KeyPrefixLogHeight} {
+		it, err := db.Iterator([]byte{prefix}, []byte{prefix + 1})
+		require.NoError(t, err)
+		require.False(t, it.Valid(), "prefix %d", prefix)
+		require.NoError(t, it.Close())
+	}
+}
+
+// testIndexerPrune indexes a tx with a log in every block from 1 to 4, prunes the blocks below 3 and checks the
+// txs, the log filter and the gaps.
+func testIndexerPrune(t *testing.T, clientCtx client.Context, idxer ethermint.EVMTxIndexer) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+	addr := common.BigToAddress(big.NewInt(10))
+
+	var txHashes []common.Hash
+	for height := int64(1); height <= 4; height++ {
+		to := common.BigToAddress(big.NewInt(1))
+		tx := types.NewTx(
+			nil, uint64(height), &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+		)
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+		txHashes = append(txHashes, txHash)
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), types.DefaultEVMDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		bz, err := json.Marshal(types.NewLogFromEth(&ethtypes.Log{Address: addr}))
+		require.NoError(t, err)
+		require.NoError(t, idxer.IndexBlock(
+			&tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ExecTxResult{{Code: 0, Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: types.AttributeKeyTxLog, Value: string(bz)},
+				}},
+			}}},
+			nil,
+		))
+	}
+	require.NoError(t, idxer.RecordGap(6, 7))
+	require.NoError(t, idxer.RecordGap(1, 1))
+	require.NoError(t, idxer.RecordGap(2, 3))
+	require.NoError(t, idxer.RecordGap(9, 9))
+	require.NoError(t, idxer.RecordGap(8, 8))
+	gaps, err := idxer.Gaps()
+	require.NoError(t, err)
+	require.Equal(t, []ethermint.IndexerGap{{From: 1, To: 3}, {From: 6, To: 9}}, gaps)
+
+	require.NoError(t, idxer.PruneBlocks(3))
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), last)
+
+	for i, txHash := range txHashes {
+		height := int64(i + 1)
+		res1, err1 := idxer.GetByTxHash(txHash)
+		res2, err2 := idxer.GetByBlockAndIndex(height, 0)
+		if height < 3 {
+			require.Error(t, err1)
+			require.Error(t, err2)
+			continue
+		}
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		require.Equal(t, res1, res2)
+	}
+
+	// the pruned heights are candidates again
+	heights, err := idxer.FilterLogHeights(1, 4, []common.Address{addr}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4}, heights)
+	heights, err = idxer.FilterLogHeights(1, 4, []common.Address{common.BigToAddress(big.NewInt(11))}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, heights)
+
+	gaps, err = idxer.Gaps()
+	require.NoError(t, err)
+	require.Equal(t, []ethermint.IndexerGap{{From: 3, To: 3}, {From: 6, To: 9}}, gaps)
+
+	require.NoError(t, idxer.PruneBlocks(10))
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	gaps, err = idxer.Gaps()
+	require.NoError(t, err)
+	require.Empty(t, gaps)
+}
+
+func TestKVIndexerReceipts(t *testing.T) {
+	encodingConfig := config.MakeConfigForTest(nil)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	testIndexerReceipts(t, clientCtx, idxer, false)
+
+	idxer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	idxer.SetFullReceipts(true)
+	testIndexerReceipts(t, clientCtx, idxer, true)
+}
+
+// testIndexerReceipts indexes a block with a transfer followed by a dynamic fee contract creation emitting a log,
+// and checks the receipts stored by the indexer.
+func testIndexerReceipts(t *testing.T, clientCtx client.Context, idxer ethermint.EVMTxIndexer, expReceipt bool) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(big.NewInt(9000))
+
+	to := common.BigToAddress(big.NewInt(1))
+	txs := []*types.MsgEthereumTx{
+		types.NewTx(big.NewInt(9000), 0, &to, big.NewInt(1000), 21000, big.NewInt(70), nil, nil, nil, nil),
+		types.NewTx(big.NewInt(9000), 1, nil, nil, 100000, nil, big.NewInt(100), big.NewInt(10), []byte{1}, nil),
+	}
+	gasUsed := []int64{21000, 30000}
+	logAddress := common.BigToAddress(big.NewInt(10))
+	topic := common.BigToHash(big.NewInt(20))
+
+	block := &tmtypes.Block{
+		Header:     tmtypes.Header{Height: 1, ChainID: "ethermint_9000-1", ValidatorsHash: common.Hash{1}.Bytes()},
+		LastCommit: &tmtypes.Commit{},
+	}
+	require.NotEmpty(t, block.Hash())
+	var results []*abci.ExecTxResult
+	for i, tx := range txs {
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), types.DefaultEVMDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		block.Txs = append(block.Txs, txBz)
+
+		events := []abci.Event{
+			{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: tx.AsTransaction().Hash().Hex()},
+				{Key: "txIndex", Value: fmt.Sprint(i)},
+				{Key: "txGasUsed", Value: fmt.Sprint(gasUsed[i])},
+			}},
+		}
+		if i == 1 {
+			bz, err := json.Marshal(types.NewLogFromEth(&ethtypes.Log{Address: logAddress, Topics: []common.Hash{topic}, Data: []byte{2}, Index: 3}))
+			require.NoError(t, err)
+			events = append(events, abci.Event{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyTxLog, Value: string(bz)},
+			}})
+		}
+		results = append(results, &abci.ExecTxResult{Code: 0, GasUsed: gasUsed[i], Events: events})
+	}
+	finalizeEvents := []abci.Event{
+		{Type: feemarkettypes.EventTypeFeeMarket, Attributes: []abci.EventAttribute{
+			{Key: feemarkettypes.AttributeKeyBaseFee, Value: "50"},
+		}},
+	}
+	require.NoError(t, idxer.IndexBlock(block, results, finalizeEvents))
+
+	res, err := idxer.GetByTxHash(txs[0].AsTransaction().Hash())
+	require.NoError(t, err)
+	if !expReceipt {
+		require.Nil(t, res.Receipt)
+		return
+	}
+	require.Empty(t, res.Receipt.Logs)
+	res.Receipt.Logs = nil
+	require.Equal(t, &ethermint.TxReceipt{
+		BlockHash:              block.Hash(),
+		TxType:                 ethtypes.LegacyTxType,
+		From:                   from.Bytes(),
+		To:                     to.Bytes(),
+		GasLimit:               21000,
+		EffectiveGasPrice:      "70",
+		BlockCumulativeGasUsed: 21000,
+		Bloom:                  ethtypes.Bloom{}.Bytes(),
+	}, res.Receipt)
+
+	txHash := txs[1].AsTransaction().Hash()
+	res, err = idxer.GetByBlockAndIndex(1, 1)
+	require.NoError(t, err)
+	receipt := res.Receipt
+	require.NotNil(t, receipt)
+	require.Equal(t, uint32(ethtypes.DynamicFeeTxType), receipt.TxType)
+	require.Empty(t, receipt.To)
+	require.Equal(t, crypto.CreateAddress(from, 1).Bytes(), receipt.ContractAddress)
+	require.Equal(t, "60", receipt.EffectiveGasPrice)
+	require.Equal(t, uint64(51000), receipt.BlockCumulativeGasUsed)
+
+	logs := receipt.EthLogs(res, txHash)
+	require.Equal(t, []*ethtypes.Log{{
+		Address:     logAddress,
+		Topics:      []common.Hash{topic},
+		Data:        []byte{2},
+		BlockNumber: 1,
+		TxHash:      txHash,
+		TxIndex:     1,
+		BlockHash:   common.BytesToHash(block.Hash()),
+		Index:       3,
+	}}, logs)
+	require.Equal(t, ethtypes.LogsBloom(logs), receipt.Bloom)
+}
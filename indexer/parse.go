@@ -0,0 +1,195 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// ethTx is an eth tx of a block together with its indexed result and logs
+type ethTx struct {
+	hash   common.Hash
+	msg    *evmtypes.MsgEthereumTx
+	result ethermint.TxResult
+	logs   []*ethtypes.Log
+	// blockGasUsed is the gas used in the block up to and including the tx
+	blockGasUsed uint64
+}
+
+// parseBlock parses the eth txs of a block through the following steps:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds a indexer.TxResult and decodes the logs based on parsed events for every message
+// The txs which fail to decode or parse are logged and skipped.
+func parseBlock(clientCtx client.Context, logger log.Logger, block *tmtypes.Block, txResults []*abci.ExecTxResult) (txs []ethTx, err error) {
+	defer func(err *error) {
+		if e := recover(); e != nil {
+			logger.Debug("panic during parsing block results", "error", e)
+
+			if ee, ok := e.(error); ok {
+				*err = ee
+			} else {
+				*err = fmt.Errorf("panic during parsing block results: %v", e)
+			}
+		}
+	}(&err)
+
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	// the gas used by the cosmos txs before the current one
+	var blockGasUsed uint64
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		prevGasUsed := blockGasUsed
+		blockGasUsed += uint64(result.GasUsed)
+
+		tx, err := clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", block.Height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", block.Height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			entry := ethTx{
+				msg: msg.(*evmtypes.MsgEthereumTx),
+				result: ethermint.TxResult{
+					Height:     block.Height,
+					TxIndex:    uint32(txIndex),
+					MsgIndex:   uint32(msgIndex),
+					EthTxIndex: ethTxIndex,
+				},
+			}
+			if result.Code != abci.CodeTypeOK && result.Codespace != evmtypes.ModuleName {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				entry.result.GasUsed = entry.msg.GetGas()
+				entry.result.Failed = true
+				entry.hash = entry.msg.Hash()
+			} else {
+				// success or fail due to VM error
+
+				parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in results", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				entry.result.GasUsed = parsedTx.GasUsed
+				entry.result.Failed = parsedTx.Failed
+				entry.hash = parsedTx.Hash
+
+				entry.logs, err = evmtypes.DecodeMsgLogsFromEvents(result.Data, result.Events, msgIndex, uint64(block.Height))
+				if err != nil {
+					logger.Error("Fail to decode logs", "err", err, "block", block.Height, "txIndex", txIndex)
+				}
+			}
+
+			cumulativeGasUsed += entry.result.GasUsed
+			entry.result.CumulativeGasUsed = cumulativeGasUsed
+			entry.blockGasUsed = prevGasUsed + cumulativeGasUsed
+			ethTxIndex++
+
+			txs = append(txs, entry)
+		}
+	}
+	return txs, nil
+}
+
+// blockSigner returns the signer recovering the senders of the block txs, it falls back to the unprotected signer
+// if the chain id is not in the ethermint format.
+func blockSigner(logger log.Logger, block *tmtypes.Block) ethtypes.Signer {
+	chainID, err := ethermint.ParseChainID(block.ChainID)
+	if err != nil {
+		logger.Debug("failed to parse chain id", "chainID", block.ChainID, "error", err.Error())
+		chainID = nil
+	}
+	return ethtypes.LatestSignerForChainID(chainID)
+}
+
+// newTxReceipt builds the part of the receipt of the eth tx which is not in the indexer.TxResult, the base fee is
+// nil if it's unknown.
+func newTxReceipt(block *tmtypes.Block, entry *ethTx, signer ethtypes.Signer, baseFee *big.Int) *ethermint.TxReceipt {
+	tx := entry.msg.AsTransaction()
+	receipt := &ethermint.TxReceipt{
+		BlockHash:              block.Hash(),
+		TxType:                 uint32(entry.msg.TxType()),
+		GasLimit:               tx.Gas(),
+		BlobGasUsed:            tx.BlobGas(),
+		BlockCumulativeGasUsed: entry.blockGasUsed,
+		Bloom:                  ethtypes.CreateBloom(ethtypes.Receipts{{Logs: entry.logs}}).Bytes(),
+		Logs:                   make([]*ethermint.TxLog, len(entry.logs)),
+	}
+
+	// the sender is only recovered for the txs which don't carry it
+	from, err := entry.msg.GetSenderLegacy(signer)
+	if err == nil {
+		receipt.From = from.Bytes()
+	}
+	if tx.To() != nil {
+		receipt.To = tx.To().Bytes()
+	} else if err == nil {
+		receipt.ContractAddress = crypto.CreateAddress(from, tx.Nonce()).Bytes()
+	}
+
+	switch {
+	case baseFee != nil:
+		receipt.EffectiveGasPrice = entry.msg.GetEffectiveGasPrice(baseFee).String()
+	case tx.Type() == ethtypes.LegacyTxType || tx.Type() == ethtypes.AccessListTxType:
+		// the gas price is paid regardless of the base fee
+		receipt.EffectiveGasPrice = tx.GasPrice().String()
+	}
+
+	for i, txLog := range entry.logs {
+		topics := make([][]byte, len(txLog.Topics))
+		for j, topic := range txLog.Topics {
+			topics[j] = topic.Bytes()
+		}
+		receipt.Logs[i] = &ethermint.TxLog{
+			Address: txLog.Address.Bytes(),
+			Topics:  topics,
+			Data:    txLog.Data,
+			Index:   uint64(txLog.Index),
+		}
+	}
+	return receipt
+}
@@ -0,0 +1,569 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	// register the sqlite driver
+	_ "github.com/mattn/go-sqlite3"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
+)
+
+// SQLiteDriver is the database/sql driver name of the embedded sqlite database
+const SQLiteDriver = "sqlite3"
+
+// sqlSchema defines the relational tables of the sql indexer, the hashes and addresses are stored as lower case
+// hex strings, the big integers as decimal strings.
+const sqlSchema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height   INTEGER PRIMARY KEY,
+	hash     TEXT NOT NULL,
+	time     INTEGER NOT NULL,
+	tx_count INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS txs (
+	hash         TEXT PRIMARY KEY,
+	height       INTEGER NOT NULL,
+	tx_index     INTEGER NOT NULL,
+	msg_index    INTEGER NOT NULL,
+	eth_tx_index INTEGER NOT NULL,
+	type         INTEGER NOT NULL,
+	sender       TEXT NOT NULL,
+	recipient    TEXT,
+	nonce        INTEGER NOT NULL,
+	value        TEXT NOT NULL,
+	gas_limit    INTEGER NOT NULL,
+	gas_price    TEXT NOT NULL,
+	input        BLOB
+);
+CREATE UNIQUE INDEX IF NOT EXISTS txs_height_index ON txs (height, eth_tx_index);
+CREATE INDEX IF NOT EXISTS txs_sender ON txs (sender, height);
+CREATE INDEX IF NOT EXISTS txs_recipient ON txs (recipient, height);
+CREATE TABLE IF NOT EXISTS receipts (
+	tx_hash                   TEXT PRIMARY KEY,
+	height                    INTEGER NOT NULL,
+	status                    INTEGER NOT NULL,
+	gas_used                  INTEGER NOT NULL,
+	tx_cumulative_gas_used    INTEGER NOT NULL,
+	block_cumulative_gas_used INTEGER NOT NULL,
+	effective_gas_price       TEXT,
+	blob_gas_used             INTEGER NOT NULL,
+	contract_address          TEXT,
+	logs_bloom                BLOB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS receipts_height ON receipts (height);
+CREATE TABLE IF NOT EXISTS logs (
+	height    INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	tx_hash   TEXT NOT NULL,
+	address   TEXT NOT NULL,
+	topic0    TEXT,
+	topic1    TEXT,
+	topic2    TEXT,
+	topic3    TEXT,
+	data      BLOB,
+	PRIMARY KEY (height, log_index)
+);
+CREATE INDEX IF NOT EXISTS logs_address ON logs (address, height);
+CREATE INDEX IF NOT EXISTS logs_topic0 ON logs (topic0, height);
+CREATE INDEX IF NOT EXISTS logs_tx_hash ON logs (tx_hash);
+CREATE TABLE IF NOT EXISTS gaps (
+	from_height INTEGER PRIMARY KEY,
+	to_height   INTEGER NOT NULL
+);
+`
+
+// maxLogTopics is the number of the topic columns of the logs table
+const maxLogTopics = 4
+
+var _ ethermint.EVMTxIndexer = &SQLIndexer{}
+
+// SQLIndexer implements a eth tx indexer on an embedded sql database, the blocks, txs, receipts and logs are
+// stored in relational tables, so they can be queried directly with sql.
+type SQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// OpenSQLiteDB opens the sqlite database at the path, the database is created if not exists.
+func OpenSQLiteDB(path string, readOnly bool) (*sql.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", path)
+	if readOnly {
+		dsn += "&mode=ro"
+	}
+	return sql.Open(SQLiteDriver, dsn)
+}
+
+// NewSQLIndexer creates the SQLIndexer, the tables are created if not exist.
+func NewSQLIndexer(db *sql.DB, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if _, err := db.Exec(sqlSchema); err != nil {
+		return nil, errorsmod.Wrap(err, "create sql indexer schema")
+	}
+	return &SQLIndexer{db, logger, clientCtx}, nil
+}
+
+// IndexBlock index the block and all the eth txs in it, together with their receipts and logs, in a single
+// transaction. Reindexing a block replaces the existing rows.
+func (si *SQLIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxResult, finalizeEvents []abci.Event) error {
+	si.logger.Debug("(SQLIndexer) IndexBlock", "height", block.Height, "txns:", len(block.Txs))
+
+	txs, err := parseBlock(si.clientCtx, si.logger, block, txResults)
+	if err != nil {
+		return err
+	}
+	signer := blockSigner(si.logger, block)
+	baseFee := rpctypes.BaseFeeFromEvents(finalizeEvents)
+
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin", block.Height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck
+
+	if _, err := dbTx.Exec(
+		"INSERT OR REPLACE INTO blocks (height, hash, time, tx_count) VALUES (?, ?, ?, ?)",
+		block.Height, hexutil.Encode(block.Hash()), block.Time.Unix(), len(txs),
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", block.Height)
+	}
+	for i := range txs {
+		receipt := newTxReceipt(block, &txs[i], signer, baseFee)
+		if err := insertEthTx(dbTx, &txs[i], receipt); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
+		}
+	}
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", block.Height)
+	}
+	return nil
+}
+
+// insertEthTx inserts the eth tx and its receipt and logs
+func insertEthTx(dbTx *sql.Tx, entry *ethTx, receipt *ethermint.TxReceipt) error {
+	tx := entry.msg.AsTransaction()
+	hash := entry.hash.Hex()
+
+	var sender string
+	if len(receipt.From) > 0 {
+		sender = hexAddress(common.BytesToAddress(receipt.From))
+	}
+	recipient := nullableAddress(receipt.To)
+	contractAddress := nullableAddress(receipt.ContractAddress)
+	var effectiveGasPrice *string
+	if receipt.EffectiveGasPrice != "" {
+		effectiveGasPrice = &receipt.EffectiveGasPrice
+	}
+
+	if _, err := dbTx.Exec(
+		`INSERT OR REPLACE INTO txs (hash, height, tx_index, msg_index, eth_tx_index, type, sender, recipient, nonce,
+		value, gas_limit, gas_price, input) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		hash, entry.result.Height, entry.result.TxIndex, entry.result.MsgIndex, entry.result.EthTxIndex,
+		entry.msg.TxType(), sender, recipient, tx.Nonce(), tx.Value().String(), tx.Gas(), tx.GasPrice().String(), tx.Data(),
+	); err != nil {
+		return errorsmod.Wrap(err, "insert tx")
+	}
+
+	status := ethtypes.ReceiptStatusSuccessful
+	if entry.result.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+	if _, err := dbTx.Exec(
+		`INSERT OR REPLACE INTO receipts (tx_hash, height, status, gas_used, tx_cumulative_gas_used,
+		block_cumulative_gas_used, effective_gas_price, blob_gas_used, contract_address, logs_bloom)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		hash, entry.result.Height, status, entry.result.GasUsed, entry.result.CumulativeGasUsed,
+		receipt.BlockCumulativeGasUsed, effectiveGasPrice, receipt.BlobGasUsed, contractAddress, receipt.Bloom,
+	); err != nil {
+		return errorsmod.Wrap(err, "insert receipt")
+	}
+
+	for _, txLog := range entry.logs {
+		topics := make([]*string, maxLogTopics)
+		for i, topic := range txLog.Topics {
+			if i < maxLogTopics {
+				hex := topic.Hex()
+				topics[i] = &hex
+			}
+		}
+		if _, err := dbTx.Exec(
+			`INSERT OR REPLACE INTO logs (height, log_index, tx_hash, address, topic0, topic1, topic2, topic3, data)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.result.Height, txLog.Index, hash, hexAddress(txLog.Address),
+			topics[0], topics[1], topics[2], topics[3], txLog.Data,
+		); err != nil {
+			return errorsmod.Wrap(err, "insert log")
+		}
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.queryHeight("SELECT MAX(height) FROM blocks")
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.queryHeight("SELECT MIN(height) FROM blocks")
+}
+
+func (si *SQLIndexer) queryHeight(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(query).Scan(&height); err != nil {
+		return 0, err
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// PruneBlocks removes the blocks, txs, receipts and logs below retainHeight, the gaps are trimmed to retainHeight.
+func (si *SQLIndexer) PruneBlocks(retainHeight int64) error {
+	if retainHeight <= 0 {
+		return nil
+	}
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, begin", retainHeight)
+	}
+	defer dbTx.Rollback() //nolint:errcheck
+
+	for _, table := range []string{"blocks", "txs", "receipts", "logs"} {
+		if _, err := dbTx.Exec("DELETE FROM "+table+" WHERE height < ?", retainHeight); err != nil {
+			return errorsmod.Wrapf(err, "PruneBlocks %d, prune %s", retainHeight, table)
+		}
+	}
+	if _, err := dbTx.Exec("DELETE FROM gaps WHERE to_height < ?", retainHeight); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, prune gaps", retainHeight)
+	}
+	if _, err := dbTx.Exec("UPDATE gaps SET from_height = ? WHERE from_height < ?", retainHeight, retainHeight); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, trim gaps", retainHeight)
+	}
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, commit", retainHeight)
+	}
+	return nil
+}
+
+// RecordGap records the block range [from, to] which is not indexed, it's merged with the overlapping and the
+// adjacent gaps already recorded.
+func (si *SQLIndexer) RecordGap(from, to int64) error {
+	if from > to {
+		return nil
+	}
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrap(err, "RecordGap, begin")
+	}
+	defer dbTx.Rollback() //nolint:errcheck
+
+	var gapFrom, gapTo sql.NullInt64
+	if err := dbTx.QueryRow(
+		"SELECT MIN(from_height), MAX(to_height) FROM gaps WHERE to_height >= ? AND from_height <= ?", from-1, to+1,
+	).Scan(&gapFrom, &gapTo); err != nil {
+		return errorsmod.Wrap(err, "RecordGap")
+	}
+	if gapFrom.Valid {
+		from, to = min(from, gapFrom.Int64), max(to, gapTo.Int64)
+	}
+	if _, err := dbTx.Exec("DELETE FROM gaps WHERE from_height BETWEEN ? AND ?", from, to); err != nil {
+		return errorsmod.Wrap(err, "RecordGap, delete gaps")
+	}
+	if _, err := dbTx.Exec("INSERT INTO gaps (from_height, to_height) VALUES (?, ?)", from, to); err != nil {
+		return errorsmod.Wrap(err, "RecordGap, insert gap")
+	}
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrap(err, "RecordGap, commit")
+	}
+	return nil
+}
+
+// Gaps returns the recorded block ranges which are not indexed, in ascending order.
+func (si *SQLIndexer) Gaps() ([]ethermint.IndexerGap, error) {
+	rows, err := si.db.Query("SELECT from_height, to_height FROM gaps ORDER BY from_height")
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "Gaps")
+	}
+	defer rows.Close()
+
+	gaps := []ethermint.IndexerGap{}
+	for rows.Next() {
+		var gap ethermint.IndexerGap
+		if err := rows.Scan(&gap.From, &gap.To); err != nil {
+			return nil, errorsmod.Wrap(err, "Gaps")
+		}
+		gaps = append(gaps, gap)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errorsmod.Wrap(err, "Gaps")
+	}
+	return gaps, nil
+}
+
+// txResultQuery selects the columns of the indexer.TxResult and of its receipt
+const txResultQuery = `SELECT txs.hash, txs.height, txs.tx_index, txs.msg_index, txs.eth_tx_index, receipts.status,
+receipts.gas_used, receipts.tx_cumulative_gas_used, blocks.hash, txs.type, txs.sender, txs.recipient,
+receipts.contract_address, txs.gas_limit, receipts.effective_gas_price, receipts.blob_gas_used,
+receipts.block_cumulative_gas_used, receipts.logs_bloom FROM txs JOIN receipts ON receipts.tx_hash = txs.hash
+JOIN blocks ON blocks.height = txs.height `
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*ethermint.TxResult, error) {
+	res, err := si.queryTxResult(txResultQuery+"WHERE txs.hash = ?", hash.Hex())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethermint.TxResult, error) {
+	res, err := si.queryTxResult(txResultQuery+"WHERE txs.height = ? AND txs.eth_tx_index = ?", blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+func (si *SQLIndexer) queryTxResult(query string, args ...interface{}) (*ethermint.TxResult, error) {
+	var (
+		hash, blockHash, sender                       string
+		recipient, contractAddress, effectiveGasPrice sql.NullString
+		status                                        uint64
+		res                                           ethermint.TxResult
+		receipt                                       ethermint.TxReceipt
+	)
+	if err := si.db.QueryRow(query, args...).Scan(
+		&hash, &res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &status, &res.GasUsed, &res.CumulativeGasUsed,
+		&blockHash, &receipt.TxType, &sender, &recipient, &contractAddress, &receipt.GasLimit, &effectiveGasPrice,
+		&receipt.BlobGasUsed, &receipt.BlockCumulativeGasUsed, &receipt.Bloom,
+	); err != nil {
+		return nil, err
+	}
+	res.Failed = status == ethtypes.ReceiptStatusFailed
+
+	receipt.BlockHash = common.FromHex(blockHash)
+	if sender != "" {
+		receipt.From = common.HexToAddress(sender).Bytes()
+	}
+	if recipient.Valid {
+		receipt.To = common.HexToAddress(recipient.String).Bytes()
+	}
+	if contractAddress.Valid {
+		receipt.ContractAddress = common.HexToAddress(contractAddress.String).Bytes()
+	}
+	receipt.EffectiveGasPrice = effectiveGasPrice.String
+
+	var err error
+	if receipt.Logs, err = si.queryTxLogs(hash); err != nil {
+		return nil, err
+	}
+	res.Receipt = &receipt
+	return &res, nil
+}
+
+// queryTxLogs returns the logs emitted by the eth tx
+func (si *SQLIndexer) queryTxLogs(hash string) ([]*ethermint.TxLog, error) {
+	rows, err := si.db.Query(
+		"SELECT address, topic0, topic1, topic2, topic3, data, log_index FROM logs WHERE tx_hash = ? ORDER BY log_index",
+		hash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	logs := []*ethermint.TxLog{}
+	for rows.Next() {
+		var (
+			address string
+			topics  = make([]sql.NullString, maxLogTopics)
+			txLog   ethermint.TxLog
+		)
+		if err := rows.Scan(&address, &topics[0], &topics[1], &topics[2], &topics[3], &txLog.Data, &txLog.Index); err != nil {
+			return nil, err
+		}
+		txLog.Address = common.HexToAddress(address).Bytes()
+		for _, topic := range topics {
+			if !topic.Valid {
+				break
+			}
+			txLog.Topics = append(txLog.Topics, common.HexToHash(topic.String).Bytes())
+		}
+		logs = append(logs, &txLog)
+	}
+	return logs, rows.Err()
+}
+
+// FilterLogHeights returns the heights within [from, to] which contain logs emitted by one of the addresses and
+// matching the topics by position, an empty list matches any address or topic. The heights not indexed, including
+// the ones without a row in the blocks table in the middle of the indexed range, are returned as candidates, so the
+// caller must still filter the logs of the returned blocks.
+func (si *SQLIndexer) FilterLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error) {
+	first, err := si.FirstIndexedBlock()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "FilterLogHeights")
+	}
+	last, err := si.LastIndexedBlock()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "FilterLogHeights")
+	}
+	if first < 0 {
+		first = 0
+	}
+	lo, hi := max(from, first), min(to, last)
+
+	var (
+		conds     []string
+		args      = []interface{}{lo, hi}
+		noMatches bool
+	)
+	if len(addresses) > 0 {
+		values := make([]interface{}, len(addresses))
+		for i, address := range addresses {
+			values[i] = hexAddress(address)
+		}
+		conds = append(conds, inCondition("address", len(values)))
+		args = append(args, values...)
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		if i >= maxLogTopics {
+			// no log has more topics
+			noMatches = true
+			break
+		}
+		values := make([]interface{}, len(sub))
+		for j, topic := range sub {
+			values[j] = topic.Hex()
+		}
+		conds = append(conds, inCondition(fmt.Sprintf("topic%d", i), len(values)))
+		args = append(args, values...)
+	}
+	if (len(conds) == 0 && !noMatches) || lo > hi {
+		return candidateHeights(from, to, lo, hi, nil), nil
+	}
+
+	matched := []int64{}
+	if !noMatches {
+		query := "SELECT DISTINCT height FROM logs WHERE height BETWEEN ? AND ? AND " +
+			strings.Join(conds, " AND ") + " ORDER BY height"
+		if matched, err = si.queryHeights(query, args...); err != nil {
+			return nil, errorsmod.Wrap(err, "FilterLogHeights")
+		}
+	}
+	missing, err := si.missingHeights(lo, hi)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "FilterLogHeights")
+	}
+	return candidateHeights(from, to, lo, hi, mergeHeights(matched, missing)), nil
+}
+
+// missingHeights returns the heights within [from, to] without a row in the blocks table, they are either recorded
+// as gaps or failed to be indexed.
+func (si *SQLIndexer) missingHeights(from, to int64) ([]int64, error) {
+	indexed, err := si.queryHeights("SELECT height FROM blocks WHERE height BETWEEN ? AND ? ORDER BY height", from, to)
+	if err != nil {
+		return nil, err
+	}
+	missing := []int64{}
+	next := from
+	for _, height := range append(indexed, to+1) {
+		for ; next < height; next++ {
+			missing = append(missing, next)
+		}
+		next = height + 1
+	}
+	return missing, nil
+}
+
+// queryHeights returns the heights selected by the query, in the order of the rows
+func (si *SQLIndexer) queryHeights(query string, args ...interface{}) ([]int64, error) {
+	rows, err := si.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	heights := []int64{}
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, err
+		}
+		heights = append(heights, height)
+	}
+	return heights, rows.Err()
+}
+
+// mergeHeights returns the union of the ascending heights, in ascending order
+func mergeHeights(a, b []int64) []int64 {
+	result := make([]int64, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		switch {
+		case len(b) == 0 || (len(a) > 0 && a[0] < b[0]):
+			result, a = append(result, a[0]), a[1:]
+		case len(a) == 0 || b[0] < a[0]:
+			result, b = append(result, b[0]), b[1:]
+		default:
+			result, a, b = append(result, a[0]), a[1:], b[1:]
+		}
+	}
+	return result
+}
+
+// inCondition returns the sql condition matching the column against n placeholders
+func inCondition(column string, n int) string {
+	return column + " IN (" + strings.TrimSuffix(strings.Repeat("?, ", n), ", ") + ")"
+}
+
+// nullableAddress returns the lower case hex of the address, nil if it's empty
+func nullableAddress(bz []byte) *string {
+	if len(bz) == 0 {
+		return nil
+	}
+	address := hexAddress(common.BytesToAddress(bz))
+	return &address
+}
+
+// hexAddress returns the lower case hex encoding of the address
+func hexAddress(address common.Address) string {
+	return hexutil.Encode(address.Bytes())
+}
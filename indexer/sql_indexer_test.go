@@ -0,0 +1,265 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	tmlog "cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/Helios-Chain-Labs/ethermint/crypto/ethsecp256k1"
+	"github.com/Helios-Chain-Labs/ethermint/indexer"
+	"github.com/Helios-Chain-Labs/ethermint/tests"
+	"github.com/Helios-Chain-Labs/ethermint/testutil/config"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newSQLIndexer(t *testing.T, clientCtx client.Context) *indexer.SQLIndexer {
+	db, err := indexer.OpenSQLiteDB(filepath.Join(t.TempDir(), "evmindexer.sqlite"), false)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	idxer, err := indexer.NewSQLIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+	return idxer
+}
+
+func TestSQLIndexer(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(
+		nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+	)
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := config.MakeConfigForTest(nil)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), types.DefaultEVMDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Height: 1, ChainID: "ethermint_9000-1"},
+		Data:   tmtypes.Data{Txs: []tmtypes.Tx{txBz}},
+	}
+
+	testCases := []struct {
+		name        string
+		blockResult []*abci.ExecTxResult
+		expSuccess  bool
+		expFailed   bool
+	}{
+		{
+			"success",
+			[]*abci.ExecTxResult{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+						}},
+					},
+				},
+			},
+			true,
+			false,
+		},
+		{
+			"success, exceed block gas limit",
+			[]*abci.ExecTxResult{
+				{
+					Code:   11,
+					Log:    "out of gas in location: block gas meter; gasWanted: 21000",
+					Events: []abci.Event{},
+				},
+			},
+			true,
+			true,
+		},
+		{
+			"fail, invalid events",
+			[]*abci.ExecTxResult{
+				{
+					Code:   0,
+					Events: []abci.Event{},
+				},
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := newSQLIndexer(t, clientCtx)
+
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(-1), first)
+
+			require.NoError(t, idxer.IndexBlock(block, tc.blockResult, nil))
+
+			// the block is recorded even if it contains no eth tx
+			first, err = idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, block.Height, first)
+
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, block.Height, last)
+
+			res1, err := idxer.GetByTxHash(txHash)
+			if !tc.expSuccess {
+				require.Error(t, err)
+				_, err = idxer.GetByBlockAndIndex(1, 0)
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, block.Height, res1.Height)
+			require.Equal(t, uint64(21000), res1.GasUsed)
+			require.Equal(t, tc.expFailed, res1.Failed)
+
+			res2, err := idxer.GetByBlockAndIndex(1, 0)
+			require.NoError(t, err)
+			require.Equal(t, res1, res2)
+
+			// reindexing replaces the existing rows
+			require.NoError(t, idxer.IndexBlock(block, tc.blockResult, nil))
+			res3, err := idxer.GetByTxHash(txHash)
+			require.NoError(t, err)
+			require.Equal(t, res1, res3)
+		})
+	}
+}
+
+func TestSQLIndexerLogs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(
+		nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+	)
+	tx.From = common.BytesToAddress(priv.PubKey().Address().Bytes()).Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := config.MakeConfigForTest(nil)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), types.DefaultEVMDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	addrA := common.BigToAddress(big.NewInt(10))
+	addrB := common.BigToAddress(big.NewInt(11))
+	topic0 := common.BigToHash(big.NewInt(20))
+	topic1 := common.BigToHash(big.NewInt(21))
+
+	block := func(height int64) *tmtypes.Block {
+		return &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	}
+	result := func(logs ...*ethtypes.Log) []*abci.ExecTxResult {
+		events := []abci.Event{
+			{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+			}},
+		}
+		for i, log := range logs {
+			log.Index = uint(i)
+			bz, err := json.Marshal(types.NewLogFromEth(log))
+			require.NoError(t, err)
+			events = append(events, abci.Event{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyTxLog, Value: string(bz)},
+			}})
+		}
+		return []*abci.ExecTxResult{{Code: 0, Events: events}}
+	}
+
+	idxer := newSQLIndexer(t, clientCtx)
+
+	// the logs are not indexed yet, every height is a candidate
+	heights, err := idxer.FilterLogHeights(1, 3, []common.Address{addrA}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, heights)
+
+	require.NoError(t, idxer.IndexBlock(block(1), result(), nil))
+	require.NoError(t, idxer.IndexBlock(block(2), result(&ethtypes.Log{Address: addrA, Topics: []common.Hash{topic0}}), nil))
+	require.NoError(t, idxer.IndexBlock(block(3), result(), nil))
+	require.NoError(t, idxer.IndexBlock(block(4), result(
+		&ethtypes.Log{Address: addrB, Topics: []common.Hash{topic0, topic1}},
+		&ethtypes.Log{Address: addrB},
+	), nil))
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expHeight []int64
+	}{
+		{"no criteria", 1, 4, nil, nil, []int64{1, 2, 3, 4}},
+		{"address", 1, 4, []common.Address{addrA}, nil, []int64{2}},
+		{"addresses", 1, 4, []common.Address{addrA, addrB}, nil, []int64{2, 4}},
+		{"first topic", 1, 4, nil, [][]common.Hash{{topic0}}, []int64{2, 4}},
+		{"topic by position", 1, 4, nil, [][]common.Hash{{topic1}}, []int64{}},
+		{"wildcard topic", 1, 4, nil, [][]common.Hash{nil, {topic1}}, []int64{4}},
+		{"address and topic", 1, 4, []common.Address{addrA}, [][]common.Hash{nil, {topic1}}, []int64{}},
+		{"sub range", 3, 4, nil, [][]common.Hash{{topic0}}, []int64{4}},
+		{"not indexed heights", 1, 6, []common.Address{addrA}, nil, []int64{2, 5, 6}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			heights, err := idxer.FilterLogHeights(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeight, heights)
+		})
+	}
+
+	// the blocks missing in the middle of the indexed range are candidates, whether recorded as gaps or not
+	require.NoError(t, idxer.RecordGap(5, 5))
+	require.NoError(t, idxer.IndexBlock(block(7), result(), nil))
+	heights, err = idxer.FilterLogHeights(1, 8, []common.Address{addrA}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 5, 6, 8}, heights)
+	heights, err = idxer.FilterLogHeights(1, 8, nil, [][]common.Hash{nil, nil, nil, nil, {topic0}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 6, 8}, heights)
+}
+
+func TestSQLIndexerPrune(t *testing.T) {
+	encodingConfig := config.MakeConfigForTest(nil)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	testIndexerPrune(t, clientCtx, newSQLIndexer(t, clientCtx))
+}
+
+func TestSQLIndexerReceipts(t *testing.T) {
+	encodingConfig := config.MakeConfigForTest(nil)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	testIndexerReceipts(t, clientCtx, newSQLIndexer(t, clientCtx), true)
+}
@@ -30,7 +30,7 @@ const (
 
 var (
 	bankABI                 abi.ABI
-	bankContractAddress     = common.BytesToAddress([]byte{100})
+	BankContractAddress     = common.BytesToAddress([]byte{100})
 	bankGasRequiredByMethod = map[[4]byte]uint64{}
 )
 
@@ -65,18 +65,21 @@ type BankContract struct {
 }
 
 // NewBankContract creates the precompiled contract to manage native tokens
-func NewBankContract(bankKeeper types.BankKeeper, cdc codec.Codec, kvGasConfig storetypes.GasConfig) vm.PrecompiledContract {
+func NewBankContract(bankKeeper types.BankKeeper, cdc codec.Codec, kvGasConfig storetypes.GasConfig) types.StatefulPrecompiledContract {
 	return &BankContract{bankKeeper, cdc, kvGasConfig}
 }
 
 func (bc *BankContract) Address() common.Address {
-	return bankContractAddress
+	return BankContractAddress
 }
 
 // RequiredGas calculates the contract gas use
 func (bc *BankContract) RequiredGas(input []byte) uint64 {
 	// base cost to prevent large input size
 	baseCost := uint64(len(input)) * bc.kvGasConfig.WriteCostPerByte
+	if len(input) < 4 {
+		return baseCost
+	}
 	var methodID [4]byte
 	copy(methodID[:], input[:4])
 	requiredGas, ok := bankGasRequiredByMethod[methodID]
@@ -99,6 +102,9 @@ func (bc *BankContract) checkBlockedAddr(addr sdk.AccAddress) error {
 
 func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
 	// parse input
+	if len(contract.Input) < 4 {
+		return nil, errors.New("data too short to contain a method ID")
+	}
 	methodID := contract.Input[:4]
 	method, err := bankABI.MethodById(methodID)
 	if err != nil {
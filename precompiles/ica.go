@@ -0,0 +1,214 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Helios-Chain-Labs/ethermint/precompiles/bindings/cosmos/precompile/ica"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+const (
+	RegisterAccountMethodName = "registerAccount"
+	QueryAccountMethodName    = "queryAccount"
+	SubmitMsgsMethodName      = "submitMsgs"
+	SubmitMsgsResultEventName = "SubmitMsgsResult"
+
+	// DefaultICATimeout is the relative packet timeout used when submitMsgs is called with a zero timeout
+	DefaultICATimeout = time.Hour
+)
+
+var (
+	icaABI                 abi.ABI
+	ICAContractAddress     = common.BytesToAddress([]byte{102})
+	icaGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	if err := icaABI.UnmarshalJSON([]byte(ica.ICAModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range icaABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], icaABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case RegisterAccountMethodName:
+			icaGasRequiredByMethod[methodID] = 300000
+		case QueryAccountMethodName:
+			icaGasRequiredByMethod[methodID] = 100000
+		case SubmitMsgsMethodName:
+			icaGasRequiredByMethod[methodID] = 300000
+		default:
+			icaGasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+// ICAControllerKeeper defines the expected interchain accounts controller keeper
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error)
+}
+
+type ICAContract struct {
+	icaControllerKeeper ICAControllerKeeper
+	cdc                 codec.Codec
+	kvGasConfig         storetypes.GasConfig
+}
+
+// NewICAContract creates the precompiled contract to manage interchain accounts owned by contracts,
+// the packet results are delivered to the owner contracts by the ICACallbackModule.
+func NewICAContract(icaControllerKeeper ICAControllerKeeper, cdc codec.Codec, kvGasConfig storetypes.GasConfig) types.StatefulPrecompiledContract {
+	return &ICAContract{icaControllerKeeper, cdc, kvGasConfig}
+}
+
+func (ic *ICAContract) Address() common.Address {
+	return ICAContractAddress
+}
+
+// RequiredGas calculates the contract gas use
+func (ic *ICAContract) RequiredGas(input []byte) uint64 {
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * ic.kvGasConfig.WriteCostPerByte
+	if len(input) < 4 {
+		return baseCost
+	}
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	requiredGas, ok := icaGasRequiredByMethod[methodID]
+	if ok {
+		return requiredGas + baseCost
+	}
+	return baseCost
+}
+
+// icaPortID returns the controller port of the interchain accounts owned by the address.
+func icaPortID(owner common.Address) (string, error) {
+	return icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+}
+
+func (ic *ICAContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	// parse input
+	if len(contract.Input) < 4 {
+		return nil, errors.New("data too short to contain a method ID")
+	}
+	methodID := contract.Input[:4]
+	method, err := icaABI.MethodById(methodID)
+	if err != nil {
+		return nil, err
+	}
+	stateDB := evm.StateDB.(ExtStateDB)
+	precompileAddr := ic.Address()
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	switch method.Name {
+	case RegisterAccountMethodName:
+		if readonly {
+			return nil, errors.New("the method is not readonly")
+		}
+		connectionID := args[0].(string)
+		version := args[1].(string)
+		owner := sdk.AccAddress(contract.CallerAddress.Bytes()).String()
+		err = stateDB.ExecuteNativeAction(precompileAddr, nil, func(ctx sdk.Context) error {
+			return ic.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner, version)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case QueryAccountMethodName:
+		connectionID := args[0].(string)
+		portID, err := icaPortID(args[1].(common.Address))
+		if err != nil {
+			return nil, err
+		}
+		// query from storage
+		addr, found := ic.icaControllerKeeper.GetInterchainAccountAddress(stateDB.CacheContext(), connectionID, portID)
+		if !found {
+			return nil, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "port %s on connection %s", portID, connectionID)
+		}
+		return method.Outputs.Pack(addr)
+	case SubmitMsgsMethodName:
+		if readonly {
+			return nil, errors.New("the method is not readonly")
+		}
+		connectionID := args[0].(string)
+		data := args[1].([]byte)
+		timeout := args[2].(*big.Int)
+		if !timeout.IsInt64() {
+			return nil, errors.New("invalid timeout")
+		}
+		relativeTimeout := time.Duration(timeout.Int64())
+		if relativeTimeout == 0 {
+			relativeTimeout = DefaultICATimeout
+		}
+		portID, err := icaPortID(contract.CallerAddress)
+		if err != nil {
+			return nil, err
+		}
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+		var (
+			channelID string
+			seq       uint64
+		)
+		err = stateDB.ExecuteNativeAction(precompileAddr, nil, func(ctx sdk.Context) error {
+			var found bool
+			channelID, found = ic.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+			if !found {
+				return errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "port %s on connection %s", portID, connectionID)
+			}
+			timeoutTimestamp := ctx.BlockTime().Add(relativeTimeout).UnixNano()
+			seq, err = ic.icaControllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, uint64(timeoutTimestamp))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := emitSubmitMsgsResult(stateDB, precompileAddr, channelID, seq); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(seq)
+	default:
+		return nil, errors.New("unknown method")
+	}
+}
+
+// emitSubmitMsgsResult emits the SubmitMsgsResult event, so the caller can match the packet result callback.
+func emitSubmitMsgsResult(stateDB ExtStateDB, contract common.Address, channelID string, seq uint64) error {
+	event := icaABI.Events[SubmitMsgsResultEventName]
+	data, err := event.Inputs.NonIndexed().Pack(seq)
+	if err != nil {
+		return fmt.Errorf("fail to pack %s event: %w", event.Name, err)
+	}
+	stateDB.AddLog(&ethtypes.Log{
+		Address: contract,
+		Topics: []common.Hash{
+			event.ID,
+			// indexed string is stored as its hash
+			crypto.Keccak256Hash([]byte(channelID)),
+		},
+		Data: data,
+	})
+	return nil
+}
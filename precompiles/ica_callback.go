@@ -0,0 +1,161 @@
+package precompiles
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Helios-Chain-Labs/ethermint/precompiles/bindings/cosmos/precompile/icacallback"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+const (
+	PacketResultCallbackMethodName = "onPacketResultCallback"
+
+	// ICACallbackGasLimit is the gas limit of the packet result callback into the owner contract
+	ICACallbackGasLimit uint64 = 300000
+)
+
+var icaCallbackABI abi.ABI
+
+func init() {
+	if err := icaCallbackABI.UnmarshalJSON([]byte(icacallback.ICACallbackMetaData.ABI)); err != nil {
+		panic(err)
+	}
+}
+
+// EVMKeeper defines the expected evm keeper to call the owner contracts
+type EVMKeeper interface {
+	CallEVM(ctx sdk.Context, from common.Address, contract *common.Address, value *big.Int, data []byte, gasLimit uint64) (*types.MsgEthereumTxResponse, error)
+}
+
+// ICACallbackModule is the underlying application of the interchain accounts controller middleware,
+// it delivers the results of the packets sent by the ICAContract to the owner contracts.
+type ICACallbackModule struct {
+	evmKeeper EVMKeeper
+}
+
+var _ porttypes.IBCModule = ICACallbackModule{}
+
+// NewICACallbackModule creates the application to wrap with the interchain accounts controller middleware
+func NewICACallbackModule(evmKeeper EVMKeeper) ICACallbackModule {
+	return ICACallbackModule{evmKeeper}
+}
+
+// OnChanOpenInit accepts the version negotiated by the controller middleware,
+// the channel capability is owned by the controller keeper.
+func (m ICACallbackModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry is never called on the controller chain
+func (m ICACallbackModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by the controller chain")
+}
+
+func (m ICACallbackModule) OnChanOpenAck(_ sdk.Context, _, _, _, _ string) error {
+	return nil
+}
+
+// OnChanOpenConfirm is never called on the controller chain
+func (m ICACallbackModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by the controller chain")
+}
+
+func (m ICACallbackModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+func (m ICACallbackModule) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnRecvPacket is never called on the controller chain
+func (m ICACallbackModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket calls back the owner contract with the result of the packet
+func (m ICACallbackModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+	m.onPacketResult(ctx, packet, ack.Success())
+	return nil
+}
+
+// OnTimeoutPacket calls back the owner contract with a failed result
+func (m ICACallbackModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	m.onPacketResult(ctx, packet, false)
+	return nil
+}
+
+// onPacketResult calls the onPacketResultCallback method of the owner contract, the failure of the callback is
+// logged and don't fail the packet lifecycle, the state changes of a failed callback are discarded.
+// The callback runs in its own EVM, so the relayer precompile rejects the results of the interchain accounts packets.
+func (m ICACallbackModule) onPacketResult(ctx sdk.Context, packet channeltypes.Packet, ack bool) {
+	logger := ctx.Logger().With("module", "precompiles", "port", packet.SourcePort, "channel", packet.SourceChannel, "sequence", packet.Sequence)
+	owner, err := icaOwner(packet.SourcePort)
+	if err != nil {
+		logger.Error("invalid interchain account owner", "error", err.Error())
+		return
+	}
+	data, err := icaCallbackABI.Pack(PacketResultCallbackMethodName, packet.SourceChannel, packet.Sequence, ack)
+	if err != nil {
+		logger.Error("fail to pack packet result callback", "error", err.Error())
+		return
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	if _, err := m.evmKeeper.CallEVM(cacheCtx, ICAContractAddress, &owner, nil, data, ICACallbackGasLimit); err != nil {
+		logger.Info("packet result callback failed", "owner", owner.Hex(), "error", err.Error())
+		return
+	}
+	commit()
+}
+
+// icaOwner parses the owner address from the controller port.
+func icaOwner(portID string) (common.Address, error) {
+	owner, found := strings.CutPrefix(portID, icatypes.ControllerPortPrefix)
+	if !found {
+		return common.Address{}, errors.New("not an interchain accounts controller port")
+	}
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(addr), nil
+}
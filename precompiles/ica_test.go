@@ -0,0 +1,309 @@
+package precompiles_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Helios-Chain-Labs/ethermint/app"
+	"github.com/Helios-Chain-Labs/ethermint/precompiles"
+	"github.com/Helios-Chain-Labs/ethermint/precompiles/bindings/cosmos/precompile/ica"
+	"github.com/Helios-Chain-Labs/ethermint/testutil"
+	"github.com/Helios-Chain-Labs/ethermint/testutil/ibctesting"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+const testConnectionID = "connection-0"
+
+var (
+	// recorderCode stores the ack, seq and caller of the packet result callback in the slots 0, 1 and 2,
+	// and returns true.
+	recorderCode = common.FromHex("60443560005560243560015533600255600160005260206000f3")
+	// revertCode stores 1 in the slot 0 and reverts.
+	revertCode = common.FromHex("600160005560006000fd")
+)
+
+type ICATestSuite struct {
+	testutil.BaseTestSuite
+
+	owner common.Address
+}
+
+func TestICATestSuite(t *testing.T) {
+	suite.Run(t, new(ICATestSuite))
+}
+
+func (suite *ICATestSuite) SetupTest() {
+	suite.BaseTestSuite.SetupTest()
+	suite.owner = common.BytesToAddress([]byte("owner"))
+}
+
+func (suite *ICATestSuite) contract() types.StatefulPrecompiledContract {
+	return precompiles.NewICAContract(suite.App.ICAControllerKeeper, suite.App.AppCodec(), storetypes.KVGasConfig())
+}
+
+// run calls the ICA precompile from the owner and returns the unpacked outputs.
+func (suite *ICATestSuite) run(readonly bool, method string, args ...interface{}) ([]interface{}, error) {
+	return runICA(suite.T(), suite.App, suite.Ctx, suite.owner, readonly, method, args...)
+}
+
+// runICA calls the ICA precompile of the app from the caller and returns the unpacked outputs.
+func runICA(t *testing.T, ethermintApp *app.EthermintApp, ctx sdk.Context, caller common.Address, readonly bool, method string, args ...interface{}) ([]interface{}, error) {
+	icaABI, err := ica.ICAModuleMetaData.GetAbi()
+	require.NoError(t, err)
+	input, err := icaABI.Pack(method, args...)
+	require.NoError(t, err)
+
+	stateDB := statedb.New(ctx, ethermintApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	chainCfg := ethermintApp.EvmKeeper.GetParams(ctx).ChainConfig.EthereumConfig(ethermintApp.EvmKeeper.ChainID())
+	evm := vm.NewEVM(vm.BlockContext{}, vm.TxContext{}, stateDB, chainCfg, vm.Config{})
+
+	precompile := precompiles.NewICAContract(ethermintApp.ICAControllerKeeper, ethermintApp.AppCodec(), storetypes.KVGasConfig())
+	contract := vm.NewContract(vm.AccountRef(caller), vm.AccountRef(precompile.Address()), new(uint256.Int), precompile.RequiredGas(input))
+	contract.Input = input
+	bz, err := precompile.Run(evm, contract, readonly)
+	if err != nil {
+		return nil, err
+	}
+	require.NoError(t, stateDB.Commit())
+	return icaABI.Unpack(method, bz)
+}
+
+func (suite *ICATestSuite) TestRegisterAccount() {
+	_, err := suite.run(true, precompiles.RegisterAccountMethodName, testConnectionID, "")
+	suite.Require().ErrorContains(err, "not readonly")
+
+	// the connection doesn't exist
+	_, err = suite.run(false, precompiles.RegisterAccountMethodName, testConnectionID, "")
+	suite.Require().Error(err)
+}
+
+func (suite *ICATestSuite) TestQueryAccount() {
+	_, err := suite.run(true, precompiles.QueryAccountMethodName, testConnectionID, suite.owner)
+	suite.Require().ErrorIs(err, icatypes.ErrInterchainAccountNotFound)
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(suite.owner.Bytes()).String())
+	suite.Require().NoError(err)
+	suite.App.ICAControllerKeeper.SetInterchainAccountAddress(suite.Ctx, testConnectionID, portID, "host-account")
+
+	res, err := suite.run(true, precompiles.QueryAccountMethodName, testConnectionID, suite.owner)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]interface{}{"host-account"}, res)
+}
+
+func (suite *ICATestSuite) TestSubmitMsgs() {
+	_, err := suite.run(true, precompiles.SubmitMsgsMethodName, testConnectionID, []byte{}, big.NewInt(0))
+	suite.Require().ErrorContains(err, "not readonly")
+
+	_, err = suite.run(false, precompiles.SubmitMsgsMethodName, testConnectionID, []byte{}, big.NewInt(-1))
+	suite.Require().Error(err)
+
+	_, err = suite.run(false, precompiles.SubmitMsgsMethodName, testConnectionID, []byte{}, big.NewInt(0))
+	suite.Require().ErrorIs(err, icatypes.ErrActiveChannelNotFound)
+}
+
+func (suite *ICATestSuite) setCode(addr common.Address, code []byte) {
+	stateDB := suite.StateDB()
+	stateDB.SetCode(addr, code)
+	suite.Require().NoError(stateDB.Commit())
+}
+
+func (suite *ICATestSuite) packet(seq uint64) channeltypes.Packet {
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(suite.owner.Bytes()).String())
+	suite.Require().NoError(err)
+	return channeltypes.Packet{
+		Sequence:      seq,
+		SourcePort:    portID,
+		SourceChannel: "channel-0",
+	}
+}
+
+func (suite *ICATestSuite) slot(i int64) common.Hash {
+	return suite.App.EvmKeeper.GetState(suite.Ctx, suite.owner, common.BigToHash(big.NewInt(i)))
+}
+
+func (suite *ICATestSuite) TestPacketResultCallback() {
+	icaAddress := suite.contract().Address()
+
+	testCases := []struct {
+		name   string
+		code   []byte
+		result func(precompiles.ICACallbackModule, channeltypes.Packet) error
+		expAck common.Hash
+		expSeq uint64
+	}{
+		{
+			"success acknowledgement",
+			recorderCode,
+			func(module precompiles.ICACallbackModule, packet channeltypes.Packet) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				return module.OnAcknowledgementPacket(suite.Ctx, packet, ack.Acknowledgement(), nil)
+			},
+			common.BigToHash(big.NewInt(1)),
+			1,
+		},
+		{
+			"error acknowledgement",
+			recorderCode,
+			func(module precompiles.ICACallbackModule, packet channeltypes.Packet) error {
+				ack := channeltypes.NewErrorAcknowledgement(icatypes.ErrUnknownDataType)
+				return module.OnAcknowledgementPacket(suite.Ctx, packet, ack.Acknowledgement(), nil)
+			},
+			common.Hash{},
+			2,
+		},
+		{
+			"timeout",
+			recorderCode,
+			func(module precompiles.ICACallbackModule, packet channeltypes.Packet) error {
+				return module.OnTimeoutPacket(suite.Ctx, packet, nil)
+			},
+			common.Hash{},
+			3,
+		},
+		{
+			"reverted callback is discarded",
+			revertCode,
+			func(module precompiles.ICACallbackModule, packet channeltypes.Packet) error {
+				return module.OnTimeoutPacket(suite.Ctx, packet, nil)
+			},
+			common.Hash{},
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setCode(suite.owner, tc.code)
+
+			seq := tc.expSeq
+			if seq == 0 {
+				seq = 1
+			}
+			module := precompiles.NewICACallbackModule(suite.App.EvmKeeper)
+			suite.Require().NoError(tc.result(module, suite.packet(seq)))
+
+			suite.Require().Equal(tc.expAck, suite.slot(0))
+			suite.Require().Equal(common.BigToHash(new(big.Int).SetUint64(tc.expSeq)), suite.slot(1))
+			if tc.expSeq != 0 {
+				suite.Require().Equal(common.BytesToHash(icaAddress.Bytes()), suite.slot(2))
+			}
+		})
+	}
+}
+
+func (suite *ICATestSuite) TestInvalidAcknowledgement() {
+	module := precompiles.NewICACallbackModule(suite.App.EvmKeeper)
+	err := module.OnAcknowledgementPacket(suite.Ctx, suite.packet(1), []byte("invalid"), nil)
+	suite.Require().Error(err)
+}
+
+// the callback to an account without code is a no-op
+func (suite *ICATestSuite) TestCallbackWithoutCode() {
+	module := precompiles.NewICACallbackModule(suite.App.EvmKeeper)
+	suite.Require().NoError(module.OnTimeoutPacket(suite.Ctx, suite.packet(1), nil))
+	suite.Require().Equal(common.Hash{}, suite.slot(1))
+}
+
+// TestICAWithCoordinator registers the interchain account of a contract on a host chain, executes a msg with it
+// and delivers the acknowledgement and the timeout of the packets to the contract.
+func TestICAWithCoordinator(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, ibctesting.SetupEthermintApp, ibctesting.SetupHostApp)
+	chainA, chainB := coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2))
+	controllerApp := chainA.App.(ibctesting.EthermintApp).EthermintApp
+	hostApp := chainB.App.(*ibctesting.HostApp)
+
+	path := ibctesting.NewPath(chainA, chainB)
+	coord.SetupConnections(path)
+
+	owner := common.BytesToAddress([]byte("owner"))
+	stateDB := statedb.New(chainA.GetContext(), controllerApp.EvmKeeper, statedb.NewEmptyTxConfig(common.Hash{}))
+	stateDB.SetCode(owner, recorderCode)
+	require.NoError(t, stateDB.Commit())
+
+	// register the interchain account and open the channel
+	_, err := runICA(t, controllerApp, chainA.GetContext(), owner, false, precompiles.RegisterAccountMethodName, path.EndpointA.ConnectionID, "")
+	require.NoError(t, err)
+	coord.CommitBlock(chainA)
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	require.NoError(t, err)
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(0)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	res, err := runICA(t, controllerApp, chainA.GetContext(), owner, true, precompiles.QueryAccountMethodName, path.EndpointA.ConnectionID, owner)
+	require.NoError(t, err)
+	icaAddr := sdk.MustAccAddressFromBech32(res[0].(string))
+
+	// fund the interchain account on the host chain
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, hostApp.BankKeeper.SendCoins(chainB.GetContext(), chainB.SenderAccount.GetAddress(), icaAddr, amount))
+	coord.CommitBlock(chainB)
+
+	// submitMsgs returns the packet sequence, the packet is built the same way as the controller keeper
+	submit := func(msgs []proto.Message, timeout time.Duration) channeltypes.Packet {
+		data, err := icatypes.SerializeCosmosTx(hostApp.AppCodec(), msgs, icatypes.EncodingProtobuf)
+		require.NoError(t, err)
+		ctx := chainA.GetContext()
+		res, err := runICA(t, controllerApp, ctx, owner, false, precompiles.SubmitMsgsMethodName, path.EndpointA.ConnectionID, data, big.NewInt(int64(timeout)))
+		require.NoError(t, err)
+		coord.CommitBlock(chainA)
+
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+		if timeout == 0 {
+			timeout = precompiles.DefaultICATimeout
+		}
+		return channeltypes.NewPacket(
+			packetData.GetBytes(), res[0].(uint64),
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+			clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		)
+	}
+	slot := func(i int64) common.Hash {
+		return controllerApp.EvmKeeper.GetState(chainA.GetContext(), owner, common.BigToHash(big.NewInt(i)))
+	}
+
+	// the msg is executed by the interchain account and the acknowledgement is delivered to the owner
+	recipient := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := hostApp.BankKeeper.GetBalance(chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+	packet := submit([]proto.Message{banktypes.NewMsgSend(icaAddr, recipient, amount)}, 0)
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.Equal(t, balance.Add(amount[0]), hostApp.BankKeeper.GetBalance(chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+	require.Equal(t, common.BigToHash(big.NewInt(1)), slot(0))
+	require.Equal(t, common.BigToHash(big.NewInt(1)), slot(1))
+	require.Equal(t, common.BytesToHash(precompiles.ICAContractAddress.Bytes()), slot(2))
+
+	// the packet times out on the host chain and the timeout is delivered to the owner
+	packet = submit([]proto.Message{banktypes.NewMsgSend(icaAddr, recipient, amount)}, time.Minute)
+	coord.IncrementTimeBy(time.Hour)
+	coord.CommitBlock(chainB)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+
+	require.Equal(t, common.Hash{}, slot(0))
+	require.Equal(t, common.BigToHash(big.NewInt(2)), slot(1))
+}
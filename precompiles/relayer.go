@@ -0,0 +1,190 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/Helios-Chain-Labs/ethermint/precompiles/bindings/cosmos/precompile/relayer"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+const (
+	CreateClientMethodName          = "createClient"
+	UpdateClientMethodName          = "updateClient"
+	UpgradeClientMethodName         = "upgradeClient"
+	SubmitMisbehaviourMethodName    = "submitMisbehaviour"
+	ConnectionOpenInitMethodName    = "connectionOpenInit"
+	ConnectionOpenTryMethodName     = "connectionOpenTry"
+	ConnectionOpenAckMethodName     = "connectionOpenAck"
+	ConnectionOpenConfirmMethodName = "connectionOpenConfirm"
+	ChannelOpenInitMethodName       = "channelOpenInit"
+	ChannelOpenTryMethodName        = "channelOpenTry"
+	ChannelOpenAckMethodName        = "channelOpenAck"
+	ChannelOpenConfirmMethodName    = "channelOpenConfirm"
+	ChannelCloseInitMethodName      = "channelCloseInit"
+	ChannelCloseConfirmMethodName   = "channelCloseConfirm"
+	RecvPacketMethodName            = "recvPacket"
+	AcknowledgementMethodName       = "acknowledgement"
+	TimeoutMethodName               = "timeout"
+	TimeoutOnCloseMethodName        = "timeoutOnClose"
+
+	UpdateClientAndConnectionOpenInitMethodName    = "updateClientAndConnectionOpenInit"
+	UpdateClientAndConnectionOpenTryMethodName     = "updateClientAndConnectionOpenTry"
+	UpdateClientAndConnectionOpenAckMethodName     = "updateClientAndConnectionOpenAck"
+	UpdateClientAndConnectionOpenConfirmMethodName = "updateClientAndConnectionOpenConfirm"
+	UpdateClientAndChannelOpenInitMethodName       = "updateClientAndChannelOpenInit"
+	UpdateClientAndChannelOpenTryMethodName        = "updateClientAndChannelOpenTry"
+	UpdateClientAndChannelOpenAckMethodName        = "updateClientAndChannelOpenAck"
+	UpdateClientAndChannelOpenConfirmMethodName    = "updateClientAndChannelOpenConfirm"
+	UpdateClientAndRecvPacketMethodName            = "updateClientAndRecvPacket"
+	UpdateClientAndAcknowledgementMethodName       = "updateClientAndAcknowledgement"
+	UpdateClientAndTimeoutMethodName               = "updateClientAndTimeout"
+	UpdateClientAndChannelCloseInitMethodName      = "updateClientAndChannelCloseInit"
+	UpdateClientAndChannelCloseConfirmMethodName   = "updateClientAndChannelCloseConfirm"
+)
+
+var (
+	relayerABI             abi.ABI
+	RelayerContractAddress = common.BytesToAddress([]byte{101})
+
+	// relayerMethods executes the messages of the single input methods
+	relayerMethods = map[string]func(*Executor) ([]byte, error){
+		CreateClientMethodName:          exec[clienttypes.MsgCreateClient],
+		UpdateClientMethodName:          exec[clienttypes.MsgUpdateClient],
+		UpgradeClientMethodName:         exec[clienttypes.MsgUpgradeClient],
+		SubmitMisbehaviourMethodName:    exec[clienttypes.MsgSubmitMisbehaviour],
+		ConnectionOpenInitMethodName:    exec[connectiontypes.MsgConnectionOpenInit],
+		ConnectionOpenTryMethodName:     exec[connectiontypes.MsgConnectionOpenTry],
+		ConnectionOpenAckMethodName:     exec[connectiontypes.MsgConnectionOpenAck],
+		ConnectionOpenConfirmMethodName: exec[connectiontypes.MsgConnectionOpenConfirm],
+		ChannelOpenInitMethodName:       exec[channeltypes.MsgChannelOpenInit],
+		ChannelOpenTryMethodName:        exec[channeltypes.MsgChannelOpenTry],
+		ChannelOpenAckMethodName:        exec[channeltypes.MsgChannelOpenAck],
+		ChannelOpenConfirmMethodName:    exec[channeltypes.MsgChannelOpenConfirm],
+		ChannelCloseInitMethodName:      exec[channeltypes.MsgChannelCloseInit],
+		ChannelCloseConfirmMethodName:   exec[channeltypes.MsgChannelCloseConfirm],
+		RecvPacketMethodName:            exec[channeltypes.MsgRecvPacket],
+		AcknowledgementMethodName:       exec[channeltypes.MsgAcknowledgement],
+		TimeoutMethodName:               exec[channeltypes.MsgTimeout],
+		TimeoutOnCloseMethodName:        exec[channeltypes.MsgTimeoutOnClose],
+	}
+
+	// relayerUpdateClientMethods executes a client update followed by the message of the second input
+	relayerUpdateClientMethods = map[string]func(*Executor) error{
+		UpdateClientAndConnectionOpenInitMethodName:    execMultiple[clienttypes.MsgUpdateClient, connectiontypes.MsgConnectionOpenInit],
+		UpdateClientAndConnectionOpenTryMethodName:     execMultiple[clienttypes.MsgUpdateClient, connectiontypes.MsgConnectionOpenTry],
+		UpdateClientAndConnectionOpenAckMethodName:     execMultiple[clienttypes.MsgUpdateClient, connectiontypes.MsgConnectionOpenAck],
+		UpdateClientAndConnectionOpenConfirmMethodName: execMultiple[clienttypes.MsgUpdateClient, connectiontypes.MsgConnectionOpenConfirm],
+		UpdateClientAndChannelOpenInitMethodName:       execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgChannelOpenInit],
+		UpdateClientAndChannelOpenTryMethodName:        execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgChannelOpenTry],
+		UpdateClientAndChannelOpenAckMethodName:        execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgChannelOpenAck],
+		UpdateClientAndChannelOpenConfirmMethodName:    execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgChannelOpenConfirm],
+		UpdateClientAndRecvPacketMethodName:            execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgRecvPacket],
+		UpdateClientAndAcknowledgementMethodName:       execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgAcknowledgement],
+		UpdateClientAndTimeoutMethodName:               execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgTimeout],
+		UpdateClientAndChannelCloseInitMethodName:      execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgChannelCloseInit],
+		UpdateClientAndChannelCloseConfirmMethodName:   execMultiple[clienttypes.MsgUpdateClient, channeltypes.MsgChannelCloseConfirm],
+	}
+)
+
+func init() {
+	if err := relayerABI.UnmarshalJSON([]byte(relayer.RelayerFunctionsMetaData.ABI)); err != nil {
+		panic(err)
+	}
+}
+
+type RelayerContract struct {
+	router      MsgRouter
+	cdc         codec.Codec
+	kvGasConfig storetypes.GasConfig
+}
+
+// NewRelayerContract creates the precompiled contract to submit the IBC relayer messages, the messages are
+// dispatched with the message router and the store gas they consume is charged to the caller.
+func NewRelayerContract(router MsgRouter, cdc codec.Codec, kvGasConfig storetypes.GasConfig) types.StatefulPrecompiledContract {
+	return &RelayerContract{router, cdc, kvGasConfig}
+}
+
+func (rc *RelayerContract) Address() common.Address {
+	return RelayerContractAddress
+}
+
+// RequiredGas calculates the contract gas use, the execution of the messages is charged by Run
+func (rc *RelayerContract) RequiredGas(input []byte) uint64 {
+	// base cost to prevent large input size
+	return uint64(len(input)) * rc.kvGasConfig.WriteCostPerByte
+}
+
+func (rc *RelayerContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if readonly {
+		return nil, errors.New("the method is not readonly")
+	}
+	// parse input
+	if len(contract.Input) < 4 {
+		return nil, errors.New("data too short to contain a method ID")
+	}
+	method, err := relayerABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	e := &Executor{
+		cdc:      rc.cdc,
+		router:   rc.router,
+		stateDB:  evm.StateDB.(ExtStateDB),
+		contract: contract,
+		input:    args[0].([]byte),
+		check:    checkRelayerMsg,
+	}
+	if fn, ok := relayerMethods[method.Name]; ok {
+		res, err := fn(e)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(res)
+	}
+	if fn, ok := relayerUpdateClientMethods[method.Name]; ok {
+		e.input2 = args[1].([]byte)
+		if err := fn(e); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	}
+	return nil, fmt.Errorf("unknown method: %s", method.Name)
+}
+
+// checkRelayerMsg rejects the acknowledgements and timeouts of the interchain accounts packets. Their result is
+// delivered to the owner contract by the ICACallbackModule with CallEVM, which can't be nested in the EVM executing
+// the relayer precompile: the states of the two StateDBs would overlap, and the logs of the callback would be lost.
+func checkRelayerMsg(msg sdk.Msg) error {
+	var packet channeltypes.Packet
+	switch msg := msg.(type) {
+	case *channeltypes.MsgAcknowledgement:
+		packet = msg.Packet
+	case *channeltypes.MsgTimeout:
+		packet = msg.Packet
+	case *channeltypes.MsgTimeoutOnClose:
+		packet = msg.Packet
+	default:
+		return nil
+	}
+	if strings.HasPrefix(packet.SourcePort, icatypes.ControllerPortPrefix) {
+		return fmt.Errorf("the result of the interchain accounts packets can't be relayed by a contract, port %s", packet.SourcePort)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package precompiles_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Helios-Chain-Labs/ethermint/precompiles"
+	"github.com/Helios-Chain-Labs/ethermint/precompiles/bindings/cosmos/precompile/relayer"
+	"github.com/Helios-Chain-Labs/ethermint/testutil"
+)
+
+type RelayerTestSuite struct {
+	testutil.BaseTestSuite
+
+	relayerABI abi.ABI
+	caller     common.Address
+}
+
+func TestRelayerTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerTestSuite))
+}
+
+func (suite *RelayerTestSuite) SetupTest() {
+	suite.BaseTestSuite.SetupTest()
+	relayerABI, err := relayer.RelayerFunctionsMetaData.GetAbi()
+	suite.Require().NoError(err)
+	suite.relayerABI = *relayerABI
+	suite.caller = common.BytesToAddress([]byte("relayer"))
+}
+
+// run calls the relayer precompile from the caller with the gas limit, and returns the outputs and the gas left.
+func (suite *RelayerTestSuite) run(readonly bool, gas uint64, method string, args ...interface{}) ([]interface{}, uint64, error) {
+	input, err := suite.relayerABI.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	stateDB := suite.StateDB()
+	chainCfg := suite.App.EvmKeeper.GetParams(suite.Ctx).ChainConfig.EthereumConfig(suite.App.EvmKeeper.ChainID())
+	evm := vm.NewEVM(vm.BlockContext{}, vm.TxContext{}, stateDB, chainCfg, vm.Config{})
+
+	precompile := precompiles.NewRelayerContract(suite.App.MsgServiceRouter(), suite.App.AppCodec(), storetypes.KVGasConfig())
+	contract := vm.NewContract(vm.AccountRef(suite.caller), vm.AccountRef(precompile.Address()), new(uint256.Int), gas)
+	contract.Input = input
+	bz, err := precompile.Run(evm, contract, readonly)
+	if err != nil {
+		return nil, contract.Gas, err
+	}
+	suite.Require().NoError(stateDB.Commit())
+	res, err := suite.relayerABI.Unpack(method, bz)
+	suite.Require().NoError(err)
+	return res, contract.Gas, nil
+}
+
+// createClientMsg returns a MsgCreateClient of a tendermint light client signed by the signer.
+func (suite *RelayerTestSuite) createClientMsg(signer common.Address) []byte {
+	height := clienttypes.NewHeight(1, 10)
+	clientState := ibctm.NewClientState(
+		"counterparty-1", ibctm.DefaultTrustLevel, time.Hour, 2*time.Hour, 10*time.Second,
+		height, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"},
+	)
+	consensusState := ibctm.NewConsensusState(
+		suite.Ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), make([]byte, 32),
+	)
+	msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, sdk.AccAddress(signer.Bytes()).String())
+	suite.Require().NoError(err)
+	bz, err := suite.App.AppCodec().Marshal(msg)
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *RelayerTestSuite) TestCreateClient() {
+	gas := uint64(1000000)
+	res, gasLeft, err := suite.run(false, gas, precompiles.CreateClientMethodName, suite.createClientMsg(suite.caller))
+	suite.Require().NoError(err)
+
+	var resp clienttypes.MsgCreateClientResponse
+	suite.Require().NoError(suite.App.AppCodec().Unmarshal(res[0].([]byte), &resp))
+	_, found := suite.App.IBCKeeper.ClientKeeper.GetClientState(suite.Ctx, "07-tendermint-0")
+	suite.Require().True(found)
+
+	// the store gas consumed by the message is charged
+	suite.Require().Less(gasLeft, gas)
+}
+
+func (suite *RelayerTestSuite) TestOutOfGas() {
+	_, gasLeft, err := suite.run(false, 1000, precompiles.CreateClientMethodName, suite.createClientMsg(suite.caller))
+	suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+	suite.Require().Zero(gasLeft)
+
+	// the native state is reverted
+	_, found := suite.App.IBCKeeper.ClientKeeper.GetClientState(suite.Ctx, "07-tendermint-0")
+	suite.Require().False(found)
+}
+
+func (suite *RelayerTestSuite) TestUnauthenticatedCaller() {
+	other := common.BytesToAddress([]byte("other"))
+	_, _, err := suite.run(false, 1000000, precompiles.CreateClientMethodName, suite.createClientMsg(other))
+	suite.Require().ErrorContains(err, "caller is not authenticated")
+}
+
+func (suite *RelayerTestSuite) TestReadonly() {
+	_, _, err := suite.run(true, 1000000, precompiles.CreateClientMethodName, suite.createClientMsg(suite.caller))
+	suite.Require().ErrorContains(err, "not readonly")
+}
+
+func (suite *RelayerTestSuite) TestInvalidMessage() {
+	_, _, err := suite.run(false, 1000000, precompiles.RecvPacketMethodName, []byte("invalid"))
+	suite.Require().ErrorContains(err, "fail to Unmarshal")
+}
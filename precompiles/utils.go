@@ -1,140 +1,155 @@
 package precompiles
 
 import (
-	"context"
 	"errors"
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
 )
 
 type NativeMessage interface {
+	sdk.Msg
 	codec.ProtoMarshaler
 	GetSigners() []sdk.AccAddress
 }
 
+// MsgRouter defines the expected message router to dispatch the native messages
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
 type Executor struct {
 	cdc       codec.Codec
+	router    MsgRouter
 	stateDB   ExtStateDB
-	caller    common.Address
-	contract  common.Address
+	contract  *vm.Contract
 	input     []byte
 	input2    []byte
 	converter statedb.EventConverter
+	// check rejects the messages the contract can't dispatch, optional
+	check func(sdk.Msg) error
 }
 
-// exec is a generic function that executes the given action in statedb, and marshal/unmarshal the input/output
-func exec[Req any, PReq interface {
+// unmarshal decodes the native message and checks it's signed by the caller
+func unmarshal[Req any, PReq interface {
 	*Req
 	NativeMessage
-}, Resp codec.ProtoMarshaler](
-	e *Executor,
-	action func(context.Context, PReq) (Resp, error),
-) ([]byte, error) {
+}](e *Executor, input []byte) (PReq, error) {
 	msg := PReq(new(Req))
-	if err := e.cdc.Unmarshal(e.input, msg); err != nil {
+	if err := e.cdc.Unmarshal(input, msg); err != nil {
 		return nil, fmt.Errorf("fail to Unmarshal %T %w", msg, err)
 	}
+	// the signers are parsed from the message fields, which must be validated first
+	if m, ok := any(msg).(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
 
 	signers := msg.GetSigners()
 	if len(signers) != 1 {
 		return nil, errors.New("don't support multi-signers message")
 	}
 	caller := common.BytesToAddress(signers[0].Bytes())
-	if caller != e.caller {
-		return nil, fmt.Errorf("caller is not authenticated: expected %s, got %s", e.caller.Hex(), caller.Hex())
+	if caller != e.contract.CallerAddress {
+		return nil, fmt.Errorf("caller is not authenticated: expected %s, got %s", e.contract.CallerAddress.Hex(), caller.Hex())
 	}
+	return msg, nil
+}
 
-	var res Resp
-	if err := e.stateDB.ExecuteNativeAction(e.contract, e.converter, func(ctx sdk.Context) error {
-		var err error
-		res, err = action(ctx, msg)
-		return err
-	}); err != nil {
+// exec is a generic function that executes the message of the input in statedb, and returns the marshaled response
+func exec[Req any, PReq interface {
+	*Req
+	NativeMessage
+}](e *Executor) ([]byte, error) {
+	msg, err := unmarshal[Req, PReq](e, e.input)
+	if err != nil {
 		return nil, err
 	}
-
-	output, err := e.cdc.Marshal(res)
+	res, err := e.dispatch(msg)
 	if err != nil {
-		return nil, fmt.Errorf("fail to Marshal %T %w", res, err)
+		return nil, err
+	}
+	if len(res.MsgResponses) == 0 {
+		return nil, fmt.Errorf("no response for %T", msg)
 	}
-	return output, nil
+	return res.MsgResponses[0].Value, nil
 }
 
-func execMultipleWithHooks[Req any,
+// execMultiple executes the messages of the two inputs atomically in statedb
+func execMultiple[Req, Req2 any,
 	PReq interface {
 		*Req
 		NativeMessage
 	},
-	Resp codec.ProtoMarshaler,
-	Req2 any,
 	PReq2 interface {
 		*Req2
 		NativeMessage
 	},
-	Resp2 codec.ProtoMarshaler,
-](
-	e *Executor,
-	preAction func(sdk.Context, PReq, PReq2) error,
-	action func(context.Context, PReq) (Resp, error),
-	action2 func(context.Context, PReq2) (Resp2, error),
-) ([]byte, error) {
-	msg := PReq(new(Req))
-	if err := e.cdc.Unmarshal(e.input, msg); err != nil {
-		return nil, fmt.Errorf("fail to Unmarshal %T %w", msg, err)
+](e *Executor) error {
+	msg, err := unmarshal[Req, PReq](e, e.input)
+	if err != nil {
+		return err
 	}
-
-	msg2 := PReq2(new(Req2))
-	if err := e.cdc.Unmarshal(e.input2, msg2); err != nil {
-		return nil, fmt.Errorf("fail to Unmarshal %T %w", msg2, err)
+	msg2, err := unmarshal[Req2, PReq2](e, e.input2)
+	if err != nil {
+		return err
 	}
+	_, err = e.dispatch(msg, msg2)
+	return err
+}
 
-	signers := msg.GetSigners()
-	if len(signers) != 1 {
-		return nil, fmt.Errorf("expected 1 signer, got %d", len(signers))
-	}
-	if common.BytesToAddress(signers[0].Bytes()) != e.caller {
-		return nil, errors.New("caller is not authenticated")
+// dispatch executes the messages with the message router in a native action, the store gas consumed by the
+// messages is charged to the contract, the result of the first message is returned.
+func (e *Executor) dispatch(msgs ...sdk.Msg) (*sdk.Result, error) {
+	if e.check != nil {
+		for _, msg := range msgs {
+			if err := e.check(msg); err != nil {
+				return nil, err
+			}
+		}
 	}
-
-	var res Resp
-	if err := e.stateDB.ExecuteNativeAction(e.contract, e.converter, func(ctx sdk.Context) (err error) {
-		if preAction != nil {
-			if err = preAction(ctx, msg, msg2); err != nil {
+	var result *sdk.Result
+	gasMeter := storetypes.NewGasMeter(e.contract.Gas)
+	err := e.stateDB.ExecuteNativeAction(e.contract.Address(), e.converter, func(ctx sdk.Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+					panic(r)
+				}
+				err = vm.ErrOutOfGas
+			}
+		}()
+		ctx = ctx.WithGasMeter(gasMeter)
+		for i, msg := range msgs {
+			handler := e.router.Handler(msg)
+			if handler == nil {
+				return fmt.Errorf("unrecognized message type %s", sdk.MsgTypeURL(msg))
+			}
+			res, err := handler(ctx, msg)
+			if err != nil {
 				return err
 			}
+			if i == 0 {
+				result = res
+			}
 		}
-
-		res, err = action(ctx, msg)
-		if err == nil && len(e.input2) > 0 {
-			_, err = action2(ctx, msg2)
-		}
-		return
-	}); err != nil {
+		return nil
+	})
+	if !e.contract.UseGas(gasMeter.GasConsumed(), nil, tracing.GasChangeUnspecified) {
+		e.contract.UseGas(e.contract.Gas, nil, tracing.GasChangeUnspecified)
+		return nil, vm.ErrOutOfGas
+	}
+	if err != nil {
 		return nil, err
 	}
-	return e.cdc.Marshal(res)
-}
-
-func execMultiple[Req any,
-	PReq interface {
-		*Req
-		NativeMessage
-	},
-	Resp codec.ProtoMarshaler,
-	Req2 any,
-	PReq2 interface {
-		*Req2
-		NativeMessage
-	},
-	Resp2 codec.ProtoMarshaler,
-](
-	e *Executor,
-	action func(context.Context, PReq) (Resp, error),
-	action2 func(context.Context, PReq2) (Resp2, error),
-) ([]byte, error) {
-	return execMultipleWithHooks(e, nil, action, action2)
+	return result, nil
 }
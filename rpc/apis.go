@@ -30,6 +30,7 @@ import (
 	"github.com/Helios-Chain-Labs/ethermint/rpc/namespaces/ethereum/miner"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/namespaces/ethereum/net"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/namespaces/ethereum/personal"
+	"github.com/Helios-Chain-Labs/ethermint/rpc/namespaces/ethereum/trace"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/namespaces/ethereum/txpool"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/namespaces/ethereum/web3"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/stream"
@@ -51,6 +52,7 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
 
 	apiVersion = "1.0"
 )
@@ -75,7 +77,7 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewMetricsBackend(backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer), EthNamespace)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -117,7 +119,7 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewMetricsBackend(backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer), PersonalNamespace)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -127,12 +129,18 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *stream.RPCStream, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewMetricsBackend(backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer), TxPoolNamespace)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -143,7 +151,7 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewMetricsBackend(backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer), DebugNamespace)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -153,13 +161,29 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewMetricsBackend(backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer), TraceNamespace)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewMetricsBackend(backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer), MinerNamespace)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -0,0 +1,122 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package auth
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// jwtSecretLength is the length in bytes of the HS256 secret
+	jwtSecretLength = 32
+
+	// jwtExpiryTimeout is the max drift allowed between the issued-at claim of a token and
+	// the current time, same as the geth authenticated RPC.
+	jwtExpiryTimeout = 60 * time.Second
+)
+
+// ObtainJWTSecret loads the hex encoded JWT secret from the file, the secret is generated and
+// written to the file if it doesn't exist.
+func ObtainJWTSecret(path string, logger log.Logger) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		secret := common.FromHex(strings.TrimSpace(string(data)))
+		if len(secret) != jwtSecretLength {
+			return nil, fmt.Errorf("invalid JWT secret in %s: expected %d bytes, got %d", path, jwtSecretLength, len(secret))
+		}
+		logger.Info("loaded JWT secret file", "path", path)
+		return secret, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	secret := make([]byte, jwtSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+	logger.Info("generated JWT secret file", "path", path)
+	return secret, nil
+}
+
+// jwtHandler authenticates the requests with a HS256 JWT bearer token before passing them to
+// the next handler. The token must have an issued-at claim within a minute of the current time.
+type jwtHandler struct {
+	keyFunc func(token *jwt.Token) (interface{}, error)
+	next    http.Handler
+}
+
+// NewJWTHandler creates a http.Handler which requires the requests to be authenticated with
+// a JWT signed with the secret, following the geth authenticated RPC semantics.
+func NewJWTHandler(secret []byte, next http.Handler) http.Handler {
+	return &jwtHandler{
+		keyFunc: func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		},
+		next: next,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *jwtHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	strToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || strToken == "" {
+		http.Error(w, "missing token", http.StatusUnauthorized)
+		return
+	}
+
+	// the issued-at claim is checked below with a drift allowed in both directions
+	var claims jwt.RegisteredClaims
+	token, err := jwt.ParseWithClaims(strToken, &claims, h.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithoutClaimsValidation())
+
+	now := time.Now()
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case !token.Valid:
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	case !claims.VerifyExpiresAt(now, false):
+		http.Error(w, "token is expired", http.StatusUnauthorized)
+	case claims.IssuedAt == nil:
+		http.Error(w, "missing issued-at", http.StatusUnauthorized)
+	case now.Sub(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "stale token", http.StatusUnauthorized)
+	case claims.IssuedAt.Time.Sub(now) > jwtExpiryTimeout:
+		http.Error(w, "future token", http.StatusUnauthorized)
+	default:
+		h.next.ServeHTTP(w, r)
+	}
+}
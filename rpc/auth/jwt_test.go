@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObtainJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "jwt.hex")
+
+	// generated on the first call
+	secret, err := ObtainJWTSecret(path, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, secret, jwtSecretLength)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// loaded on the next ones
+	loaded, err := ObtainJWTSecret(path, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	_, err = ObtainJWTSecret(path, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestJWTHandler(t *testing.T) {
+	secret := make([]byte, jwtSecretLength)
+	secret[0] = 1
+	handler := NewJWTHandler(secret, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	sign := func(method jwt.SigningMethod, key interface{}, claims jwt.Claims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+	now := time.Now()
+	iat := func(t time.Time) jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(t)}
+	}
+
+	testCases := []struct {
+		name    string
+		token   string
+		expCode int
+	}{
+		{"valid token", sign(jwt.SigningMethodHS256, secret, iat(now)), http.StatusOK},
+		{"missing token", "", http.StatusUnauthorized},
+		{"wrong secret", sign(jwt.SigningMethodHS256, []byte("other"), iat(now)), http.StatusUnauthorized},
+		{"wrong method", sign(jwt.SigningMethodHS512, secret, iat(now)), http.StatusUnauthorized},
+		{"missing issued-at", sign(jwt.SigningMethodHS256, secret, jwt.RegisteredClaims{}), http.StatusUnauthorized},
+		{"stale token", sign(jwt.SigningMethodHS256, secret, iat(now.Add(-2*time.Minute))), http.StatusUnauthorized},
+		{"future token", sign(jwt.SigningMethodHS256, secret, iat(now.Add(2*time.Minute))), http.StatusUnauthorized},
+		{"expired token", sign(jwt.SigningMethodHS256, secret, jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(-time.Second)),
+		}), http.StatusUnauthorized},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			require.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -25,9 +25,12 @@ import (
 	"github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
 	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 	"github.com/pkg/errors"
@@ -41,10 +44,14 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 	}
 
 	req := &evmtypes.QueryCodeRequest{
-		Address: address.String(),
+		Address:    address.String(),
+		PendingTxs: b.pendingEthTxs(blockNum),
 	}
 
-	res, err := b.queryClient.Code(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	ctx, cancel := b.pendingQueryContext(blockNum)
+	defer cancel()
+
+	res, err := b.queryClient.Code(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -141,11 +148,15 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	}
 
 	req := &evmtypes.QueryStorageRequest{
-		Address: address.String(),
-		Key:     key,
+		Address:    address.String(),
+		Key:        key,
+		PendingTxs: b.pendingEthTxs(blockNum),
 	}
 
-	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	ctx, cancel := b.pendingQueryContext(blockNum)
+	defer cancel()
+
+	res, err := b.queryClient.Storage(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +165,133 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return value.Bytes(), nil
 }
 
+// AccountRangeMaxResults is the maximum number of accounts returned by AccountRange
+const AccountRangeMaxResults = 256
+
+// StorageRangeAt returns the storage of the contract at the beginning of the block, the slots are
+// ordered and paged by the raw slot rather than the hashed key of the secure trie.
+func (b *Backend) StorageRangeAt(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (*rpctypes.StorageRangeResult, error) {
+	if txIndex != 0 {
+		return nil, errors.New("only the state at the beginning of the block is supported, txIndex must be 0")
+	}
+	if maxResult < 0 {
+		return nil, fmt.Errorf("invalid maxResult %d", maxResult)
+	}
+
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	// the tags are resolved to the latest block, the pending block is not executed yet
+	if blockNum < rpctypes.EthEarliestBlockNumber {
+		latest, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		blockNum = rpctypes.BlockNumber(latest)
+	}
+	// the state at the beginning of the block is the state committed by the parent block
+	if blockNum <= 1 {
+		return nil, errors.New("genesis state is not available")
+	}
+
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address: address.String(),
+		Pagination: &query.PageRequest{
+			Key:   keyStart,
+			Limit: uint64(maxResult),
+		},
+	}
+	res, err := b.queryClient.StorageRange(rpctypes.ContextWithHeight(blockNum.Int64()-1), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.StorageRangeResult{
+		Storage: make(map[common.Hash]rpctypes.StorageEntry, len(res.Storage)),
+	}
+	for _, slot := range res.Storage {
+		key := common.HexToHash(slot.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(slot.Value),
+		}
+	}
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		result.NextKey = &nextKey
+	}
+	return result, nil
+}
+
+// AccountRange returns the accounts at the given block ordered by address, starting from the given
+// address and with at most AccountRangeMaxResults accounts. The storage of an account is truncated
+// if it has more than AccountRangeMaxStorage slots of the evm module, the next storage key of the
+// account is the slot StorageRangeAt continues from.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	noCode, noStorage bool,
+) (*rpctypes.AccountRangeResult, error) {
+	if maxResults <= 0 || maxResults > AccountRangeMaxResults {
+		maxResults = AccountRangeMaxResults
+	}
+
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{
+			Key:   start,
+			Limit: uint64(maxResults),
+		},
+		NoCode:    noCode,
+		NoStorage: noStorage,
+	}
+	res, err := b.queryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+
+	dump := &rpctypes.AccountRangeResult{
+		Accounts: make(map[string]rpctypes.AccountRangeDump, len(res.Accounts)),
+	}
+	for _, acct := range res.Accounts {
+		account := rpctypes.AccountRangeDump{
+			DumpAccount: state.DumpAccount{
+				Balance:  acct.Balance.String(),
+				Nonce:    acct.Nonce,
+				CodeHash: common.FromHex(acct.CodeHash),
+				Code:     acct.Code,
+			},
+		}
+		if len(acct.Storage) > 0 {
+			account.Storage = make(map[common.Hash]string, len(acct.Storage))
+			for _, slot := range acct.Storage {
+				account.Storage[common.HexToHash(slot.Key)] = slot.Value
+			}
+		}
+		if acct.NextStorageKey != "" {
+			nextKey := common.HexToHash(acct.NextStorageKey)
+			account.NextStorageKey = &nextKey
+		}
+		dump.Accounts[common.HexToAddress(acct.Address).Hex()] = account
+	}
+	if res.Pagination != nil {
+		dump.Next = res.Pagination.NextKey
+	}
+	return dump, nil
+}
+
 // GetBalance returns the provided account's balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -162,7 +300,8 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 	}
 
 	req := &evmtypes.QueryBalanceRequest{
-		Address: address.String(),
+		Address:    address.String(),
+		PendingTxs: b.pendingEthTxs(blockNum),
 	}
 
 	_, err = b.TendermintBlockByNumber(blockNum)
@@ -170,7 +309,10 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
-	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	ctx, cancel := b.pendingQueryContext(blockNum)
+	defer cancel()
+
+	res, err := b.queryClient.Balance(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -214,8 +356,23 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 		return &n, nil
 	}
 
-	includePending := blockNum == rpctypes.EthPendingBlockNumber
-	nonce, err := b.getAccountNonce(address, includePending, blockNum.Int64(), b.logger)
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		// the pending nonce is the one after the sender-ordered mempool txs applied on the latest state
+		ctx, cancel := b.pendingQueryContext(blockNum)
+		defer cancel()
+
+		res, err := b.queryClient.Account(ctx, &evmtypes.QueryAccountRequest{
+			Address:    address.String(),
+			PendingTxs: b.pendingEthTxs(blockNum),
+		})
+		if err != nil {
+			return nil, err
+		}
+		n = hexutil.Uint64(res.Nonce)
+		return &n, nil
+	}
+
+	nonce, err := b.getAccountNonce(address, false, blockNum.Int64(), b.logger)
 	if err != nil {
 		return nil, err
 	}
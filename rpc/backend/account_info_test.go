@@ -1,14 +1,19 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/Helios-Chain-Labs/ethermint/rpc/backend/mocks"
@@ -244,6 +249,153 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestStorageRangeAt() {
+	addr := tests.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(1))
+	next := common.BigToHash(big.NewInt(2))
+	registerStorageRange := func() {
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterStorageRange(queryClient, 1, &evmtypes.QueryStorageRangeRequest{
+			Address:    addr.String(),
+			Pagination: &query.PageRequest{Key: slot.Bytes(), Limit: 1},
+		}, &evmtypes.QueryStorageRangeResponse{
+			Storage:    evmtypes.Storage{evmtypes.NewState(slot, next)},
+			Pagination: &query.PageResponse{NextKey: next.Bytes()},
+		})
+	}
+
+	testCases := []struct {
+		name         string
+		blockNr      rpctypes.BlockNumber
+		txIndex      int
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - txIndex is not 0",
+			rpctypes.NewBlockNumber(big.NewInt(2)),
+			1,
+			func() {},
+			false,
+		},
+		{
+			"fail - genesis state",
+			rpctypes.EthEarliestBlockNumber,
+			0,
+			func() {},
+			false,
+		},
+		{
+			"fail - latest block is the first block",
+			rpctypes.EthLatestBlockNumber,
+			0,
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+			},
+			false,
+		},
+		{
+			"pass - query the state of the parent block",
+			rpctypes.NewBlockNumber(big.NewInt(2)),
+			0,
+			registerStorageRange,
+			true,
+		},
+		{
+			"pass - query the state of the parent of the latest block",
+			rpctypes.EthLatestBlockNumber,
+			0,
+			func() {
+				var header metadata.MD
+				suite.backend.ctx = rpctypes.ContextWithHeight(2)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 2)
+				registerStorageRange()
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			res, err := suite.backend.StorageRangeAt(rpctypes.BlockNumberOrHash{BlockNumber: &tc.blockNr}, tc.txIndex, addr, slot.Bytes(), 1)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&rpctypes.StorageRangeResult{
+					Storage: map[common.Hash]rpctypes.StorageEntry{
+						crypto.Keccak256Hash(slot.Bytes()): {Key: &slot, Value: next},
+					},
+					NextKey: &next,
+				}, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestAccountRange() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr, other := tests.GenerateAddress(), tests.GenerateAddress()
+	slot, nextSlot := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))
+
+	suite.SetupTest()
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	// the max results are capped
+	RegisterAccountRange(queryClient, 1, &evmtypes.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Key: addr.Bytes(), Limit: AccountRangeMaxResults},
+		NoCode:     true,
+	}, &evmtypes.QueryAccountRangeResponse{
+		Accounts: []evmtypes.RangeAccount{{
+			Address:  addr.Hex(),
+			Balance:  sdkmath.NewInt(100),
+			Nonce:    1,
+			CodeHash: common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+			Storage:  evmtypes.Storage{evmtypes.NewState(slot, slot)},
+		}, {
+			Address:        other.Hex(),
+			Balance:        sdkmath.NewInt(0),
+			CodeHash:       common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+			Storage:        evmtypes.Storage{evmtypes.NewState(slot, slot)},
+			NextStorageKey: nextSlot.Hex(),
+		}},
+		Pagination: &query.PageResponse{},
+	})
+
+	dump, err := suite.backend.AccountRange(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, addr.Bytes(), 1000, true, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&rpctypes.AccountRangeResult{
+		Accounts: map[string]rpctypes.AccountRangeDump{
+			addr.Hex(): {
+				DumpAccount: state.DumpAccount{
+					Balance:  "100",
+					Nonce:    1,
+					CodeHash: evmtypes.EmptyCodeHash,
+					Storage:  map[common.Hash]string{slot: slot.Hex()},
+				},
+			},
+			// the truncated storage is continued from the next storage key
+			other.Hex(): {
+				DumpAccount: state.DumpAccount{
+					Balance:  "0",
+					CodeHash: evmtypes.EmptyCodeHash,
+					Storage:  map[common.Hash]string{slot: slot.Hex()},
+				},
+				NextStorageKey: &nextSlot,
+			},
+		},
+	}, dump)
+
+	bz, err := json.Marshal(dump.Accounts[other.Hex()])
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), `"balance":"0"`)
+	suite.Require().Contains(string(bz), `"nextStorageKey":"`+nextSlot.Hex()+`"`)
+}
+
 func (suite *BackendTestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -73,6 +73,10 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+	RPCBlockRangeCap() int32
+	RPCFilterCap() int32
+	RPCLogsCap() int32
+	IndexerStatus() (*rpctypes.IndexerStatus, error)
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -104,6 +108,8 @@ type EVMBackend interface {
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	StorageRangeAt(blockNrOrHash rpctypes.BlockNumberOrHash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (*rpctypes.StorageRangeResult, error)
+	AccountRange(blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, noCode, noStorage bool) (*rpctypes.AccountRangeResult, error)
 
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
@@ -112,6 +118,7 @@ type EVMBackend interface {
 	BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
 	CurrentHeader() (*ethtypes.Header, error)
 	PendingTransactions() ([]*sdk.Tx, error)
+	TxPoolContent() (pending, queued rpctypes.TxPoolTransactions, err error)
 	GetCoinbase() (sdk.AccAddress, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
@@ -122,6 +129,10 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*ethermint.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error)
+	GetRawTransactionByHash(txHash common.Hash) (hexutil.Bytes, error)
+	GetRawTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (hexutil.Bytes, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
@@ -131,17 +142,21 @@ type EVMBackend interface {
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) (json.RawMessage, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*rpctypes.AccessListResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	FilterLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumberOrHash, config *rpctypes.TraceConfig) (interface{}, error)
+	IntermediateRoots(block *tmrpctypes.ResultBlock) ([]common.Hash, error)
 }
 
 var _ BackendI = (*Backend)(nil)
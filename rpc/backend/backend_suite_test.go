@@ -161,6 +161,7 @@ func (suite *BackendTestSuite) buildFormattedBlock(
 		resBlock.Block.Size(),
 		gasLimit,
 		gasUsed,
+		nil,
 		ethRPCTxs,
 		bloom,
 		common.BytesToAddress(validator.Bytes()),
@@ -375,8 +375,17 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", block.Height, "error", err)
 	}
 
+	// the blob gas fields are only part of the blocks since Cancun
+	var blobGasUsed *uint64
+	if cfg := b.ChainConfig(); cfg != nil && cfg.IsCancun(big.NewInt(block.Height), uint64(block.Time.Unix())) {
+		blobGasUsed = new(uint64)
+	}
+
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 	for txIndex, ethMsg := range msgs {
+		if blobGasUsed != nil {
+			*blobGasUsed += ethMsg.AsTransaction().BlobGas()
+		}
 		if !fullTx {
 			ethRPCTxs = append(ethRPCTxs, ethMsg.Hash())
 			continue
@@ -440,7 +449,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 
 	formattedBlock := rpctypes.FormatBlock(
 		block.Header, block.Size(),
-		gasLimit, new(big.Int).SetUint64(gasUsed),
+		gasLimit, new(big.Int).SetUint64(gasUsed), blobGasUsed,
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
 	return formattedBlock, nil
@@ -487,6 +496,7 @@ func (b *Backend) EthBlockFromTendermintBlock(
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 
+	// the EIP-7702 set code txs can't be held by a go-ethereum block, their dynamic fee form is used instead
 	txs := make([]*ethtypes.Transaction, len(msgs))
 	for i, ethMsg := range msgs {
 		txs[i] = ethMsg.AsTransaction()
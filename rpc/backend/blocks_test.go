@@ -164,6 +164,7 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 			},
 			false,
 			true,
@@ -186,6 +187,7 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 			},
 			false,
 			true,
@@ -309,6 +311,7 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 			},
 			false,
 			true,
@@ -332,6 +335,7 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 			},
 			false,
 			true,
@@ -904,6 +908,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -928,6 +933,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -952,6 +958,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccountError(queryClient)
+				RegisterParamsWithoutHeader(queryClient, 1)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -976,6 +983,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParamsError(client, height)
@@ -1006,6 +1014,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -1030,6 +1039,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -1054,6 +1064,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -1102,6 +1113,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				tc.resBlock.Block.Size(),
 				gasLimit,
 				gasUsed,
+				nil,
 				ethRPCTxs,
 				bloom,
 				common.BytesToAddress(tc.validator.Bytes()),
@@ -117,12 +117,13 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	var tx ethtypes.Transaction
-	if err := tx.UnmarshalBinary(data); err != nil {
+	// RLP decode raw transaction bytes, including the EIP-7702 set code transactions, and recover the sender
+	var ethereumTx evmtypes.MsgEthereumTx
+	if err := ethereumTx.UnmarshalBinary(data, ethtypes.LatestSignerForChainID(b.chainID)); err != nil {
 		b.logger.Error("transaction decoding failed", "error", err.Error())
 		return common.Hash{}, err
 	}
+	tx := ethereumTx.AsTransaction()
 
 	// check the local node config in case unprotected txs are disabled
 	if !b.UnprotectedAllowed() && !tx.Protected() {
@@ -130,10 +131,13 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
 	}
 
-	var ethereumTx evmtypes.MsgEthereumTx
-	if err := ethereumTx.FromSignedEthereumTx(&tx, ethtypes.LatestSignerForChainID(b.chainID)); err != nil {
-		b.logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, err
+	// blobs are not kept on chain, verify the sidecar and broadcast the tx without it
+	if sidecar := tx.BlobTxSidecar(); sidecar != nil {
+		if err := evmtypes.VerifyBlobSidecar(tx.BlobHashes(), sidecar); err != nil {
+			b.logger.Debug("tx blob sidecar verification failed", "error", err.Error())
+			return common.Hash{}, err
+		}
+		ethereumTx.FromEthereumTx(tx.WithoutBlobTxSidecar())
 	}
 
 	if err := ethereumTx.ValidateBasic(); err != nil {
@@ -182,6 +186,14 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
 		return args, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
 	}
+	if len(args.AuthorizationList) > 0 {
+		if args.To == nil {
+			return args, errors.New(`missing "to" in set code transaction`)
+		}
+		if args.GasPrice != nil {
+			return args, errors.New("set code transaction with gasPrice, maxFeePerGas and maxPriorityFeePerGas must be used")
+		}
+	}
 
 	head, err := b.CurrentHeader()
 	if err != nil {
@@ -289,6 +301,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 			AccessList:           args.AccessList,
 			ChainID:              args.ChainID,
 			Nonce:                args.Nonce,
+			AuthorizationList:    args.AuthorizationList,
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
@@ -344,6 +357,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		PendingTxs:      b.pendingEthTxs(blockNr),
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -386,6 +400,7 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 		Overrides:       bzOverrides,
+		PendingTxs:      b.pendingEthTxs(blockNr),
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -422,6 +437,87 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 executes the simulated blocks on top of the given block, and returns their json encoded
+// RPC representations along with the results of the calls.
+func (b *Backend) SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) (json.RawMessage, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.QuerySimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// CreateAccessList returns the access list accessed by the call on top of the given block, along with the gas
+// used by the call when the access list is included in the tx.
+func (b *Backend) CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		PendingTxs:      b.pendingEthTxs(blockNr),
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.AccessListResult{
+		AccessList: res.AccessList.ToEthAccessList(),
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
@@ -436,6 +437,122 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	opts := rpctypes.SimOpts{
+		BlockStateCalls: []rpctypes.SimBlock{{Calls: []evmtypes.TransactionArgs{{To: &toAddr}}}},
+	}
+	optsBz, err := json.Marshal(&opts)
+	suite.Require().NoError(err)
+	data := []byte(`[{"number":"0x2","calls":[]}]`)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    json.RawMessage
+		expPass      bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterSimulateV1Error(queryClient, &evmtypes.QuerySimulateV1Request{Opts: optsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - Returned simulated blocks",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterSimulateV1(queryClient, &evmtypes.QuerySimulateV1Request{Opts: optsBz, ChainId: suite.backend.chainID.Int64()}, data)
+			},
+			data,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SimulateV1(opts, rpctypes.BlockNumber(1))
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	args := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(&args)
+	suite.Require().NoError(err)
+	accessList := ethtypes.AccessList{{Address: tests.GenerateAddress(), StorageKeys: []common.Hash{{1}}}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterCreateAccessListError(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - Returned access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterCreateAccessList(
+					queryClient,
+					&evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()},
+					&evmtypes.QueryCreateAccessListResponse{AccessList: evmtypes.NewAccessList(&accessList), GasUsed: 30000},
+				)
+			},
+			&rpctypes.AccessListResult{AccessList: &accessList, GasUsed: 30000},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(args, rpctypes.BlockNumber(1))
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
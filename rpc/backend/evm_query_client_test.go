@@ -220,6 +220,27 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// SimulateV1, the request context is canceled once the call has completed
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, request *evmtypes.QuerySimulateV1Request, data []byte) {
+	queryClient.On("SimulateV1", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(&evmtypes.QuerySimulateV1Response{Data: data}, nil)
+}
+
+func RegisterSimulateV1Error(queryClient *mocks.EVMQueryClient, request *evmtypes.QuerySimulateV1Request) {
+	queryClient.On("SimulateV1", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, res *evmtypes.QueryCreateAccessListResponse) {
+	queryClient.On("CreateAccessList", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(res, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -313,6 +334,14 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageRange(queryClient *mocks.EVMQueryClient, height int64, req *evmtypes.QueryStorageRangeRequest, res *evmtypes.QueryStorageRangeResponse) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(height), req).Return(res, nil)
+}
+
+func RegisterAccountRange(queryClient *mocks.EVMQueryClient, height int64, req *evmtypes.QueryAccountRangeRequest, res *evmtypes.QueryAccountRangeResponse) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(height), req).Return(res, nil)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
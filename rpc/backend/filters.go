@@ -18,6 +18,7 @@ package backend
 import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 )
 
@@ -44,8 +45,22 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// FilterLogHeights returns the heights within [from, to] which may contain logs matching the addresses and topics,
+// every height of the range is returned if the indexer is disabled.
+func (b *Backend) FilterLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error) {
+	if b.indexer != nil {
+		return b.indexer.FilterLogHeights(from, to, addresses, topics)
+	}
+	heights := make([]int64, 0, max(to-from+1, 0))
+	for height := from; height <= to; height++ {
+		heights = append(heights, height)
+	}
+	return heights, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
-// by the chain indexer.
+// by the chain indexer. No bloom bits sections are processed, the logs are filtered with the
+// KV indexer instead.
 func (b *Backend) BloomStatus() (uint64, uint64) {
-	return 4096, 0
+	return params.BloomBitsBlocks, 0
 }
@@ -0,0 +1,520 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// metricsBackend records the latency and the errors of the backend calls of a namespace API, the
+// calls which can't fail are only served by the node state and are not recorded.
+type metricsBackend struct {
+	EVMBackend
+	prefix string
+}
+
+var _ EVMBackend = (*metricsBackend)(nil)
+
+// NewMetricsBackend returns the backend of the namespace API recording the metrics of its calls,
+// the backend is returned as is when the metrics are disabled.
+func NewMetricsBackend(b EVMBackend, namespace string) EVMBackend {
+	if !ethmetrics.Enabled {
+		return b
+	}
+	return &metricsBackend{EVMBackend: b, prefix: "ethermint/rpc/backend/" + namespace + "/"}
+}
+
+// observe records the latency of the backend call and its error if any.
+func (b *metricsBackend) observe(method string, start time.Time, err error) {
+	ethmetrics.GetOrRegisterTimer(b.prefix+method+"/duration", nil).UpdateSince(start)
+	if err != nil {
+		ethmetrics.GetOrRegisterCounter(b.prefix+method+"/errors", nil).Inc(1)
+	}
+}
+
+func (b *metricsBackend) Accounts() ([]common.Address, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.Accounts()
+	b.observe("Accounts", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) Syncing() (interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.Syncing()
+	b.observe("Syncing", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) ImportRawKey(privkey, password string) (common.Address, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.ImportRawKey(privkey, password)
+	b.observe("ImportRawKey", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) ListAccounts() ([]common.Address, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.ListAccounts()
+	b.observe("ListAccounts", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.NewMnemonic(uid, language, hdPath, bip39Passphrase, algo)
+	b.observe("NewMnemonic", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) IndexerStatus() (*rpctypes.IndexerStatus, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.IndexerStatus()
+	b.observe("IndexerStatus", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.Sign(address, data)
+	b.observe("Sign", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.SendTransaction(args)
+	b.observe("SendTransaction", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.SignTypedData(address, typedData)
+	b.observe("SignTypedData", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) BlockNumber() (hexutil.Uint64, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.BlockNumber()
+	b.observe("BlockNumber", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetBlockByNumber(blockNum, fullTx)
+	b.observe("GetBlockByNumber", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetBlockByHash(hash, fullTx)
+	b.observe("GetBlockByHash", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.TendermintBlockByNumber(blockNum)
+	b.observe("TendermintBlockByNumber", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.TendermintBlockResultByNumber(height)
+	b.observe("TendermintBlockResultByNumber", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.TendermintBlockByHash(blockHash)
+	b.observe("TendermintBlockByHash", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) BlockNumberFromTendermint(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.BlockNumberFromTendermint(blockNrOrHash)
+	b.observe("BlockNumberFromTendermint", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.Int, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.BlockNumberFromTendermintByHash(blockHash)
+	b.observe("BlockNumberFromTendermintByHash", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.BlockBloom(blockRes)
+	b.observe("BlockBloom", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.HeaderByNumber(blockNum)
+	b.observe("HeaderByNumber", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.HeaderByHash(blockHash)
+	b.observe("HeaderByHash", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) RPCBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults, fullTx bool) (map[string]interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.RPCBlockFromTendermintBlock(resBlock, blockRes, fullTx)
+	b.observe("RPCBlockFromTendermintBlock", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Block, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.EthBlockByNumber(blockNum)
+	b.observe("EthBlockByNumber", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) EthBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (*ethtypes.Block, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.EthBlockFromTendermintBlock(resBlock, blockRes)
+	b.observe("EthBlockFromTendermintBlock", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetCode(address, blockNrOrHash)
+	b.observe("GetCode", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetBalance(address, blockNrOrHash)
+	b.observe("GetBalance", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetStorageAt(address, key, blockNrOrHash)
+	b.observe("GetStorageAt", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetProof(address, storageKeys, blockNrOrHash)
+	b.observe("GetProof", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTransactionCount(address, blockNum)
+	b.observe("GetTransactionCount", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) StorageRangeAt(blockNrOrHash rpctypes.BlockNumberOrHash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (*rpctypes.StorageRangeResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.StorageRangeAt(blockNrOrHash, txIndex, address, keyStart, maxResult)
+	b.observe("StorageRangeAt", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) AccountRange(blockNrOrHash rpctypes.BlockNumberOrHash, startKey hexutil.Bytes, maxResults int, noCode, noStorage bool) (*rpctypes.AccountRangeResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.AccountRange(blockNrOrHash, startKey, maxResults, noCode, noStorage)
+	b.observe("AccountRange", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) ChainID() (*hexutil.Big, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.ChainID()
+	b.observe("ChainID", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GlobalMinGasPrice() (sdkmath.LegacyDec, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GlobalMinGasPrice()
+	b.observe("GlobalMinGasPrice", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.BaseFee(blockRes)
+	b.observe("BaseFee", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) CurrentHeader() (*ethtypes.Header, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.CurrentHeader()
+	b.observe("CurrentHeader", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) PendingTransactions() ([]*sdk.Tx, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.PendingTransactions()
+	b.observe("PendingTransactions", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) TxPoolContent() (pending, queued rpctypes.TxPoolTransactions, err error) {
+	start := time.Now()
+	pending, queued, err = b.EVMBackend.TxPoolContent()
+	b.observe("TxPoolContent", start, err)
+	return pending, queued, err
+}
+
+func (b *metricsBackend) GetCoinbase() (sdk.AccAddress, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetCoinbase()
+	b.observe("GetCoinbase", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.FeeHistory(blockCount, lastBlock, rewardPercentiles)
+	b.observe("FeeHistory", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.SuggestGasTipCap(baseFee)
+	b.observe("SuggestGasTipCap", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTransactionByHash(txHash)
+	b.observe("GetTransactionByHash", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTxByEthHash(txHash common.Hash) (*ethermint.TxResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTxByEthHash(txHash)
+	b.observe("GetTxByEthHash", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTxByTxIndex(height int64, txIndex uint) (*ethermint.TxResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTxByTxIndex(height, txIndex)
+	b.observe("GetTxByTxIndex", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTransactionByBlockAndIndex(block, idx)
+	b.observe("GetTransactionByBlockAndIndex", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTransactionReceipt(hash)
+	b.observe("GetTransactionReceipt", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetBlockReceipts(blockNrOrHash)
+	b.observe("GetBlockReceipts", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetRawReceipts(blockNrOrHash)
+	b.observe("GetRawReceipts", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetRawTransactionByHash(txHash common.Hash) (hexutil.Bytes, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetRawTransactionByHash(txHash)
+	b.observe("GetRawTransactionByHash", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetRawTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (hexutil.Bytes, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetRawTransactionByBlockNumberAndIndex(blockNum, idx)
+	b.observe("GetRawTransactionByBlockNumberAndIndex", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTransactionByBlockHashAndIndex(hash, idx)
+	b.observe("GetTransactionByBlockHashAndIndex", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetTransactionByBlockNumberAndIndex(blockNum, idx)
+	b.observe("GetTransactionByBlockNumberAndIndex", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.Resend(args, gasPrice, gasLimit)
+	b.observe("Resend", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.SendRawTransaction(data)
+	b.observe("SendRawTransaction", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.SetTxDefaults(args)
+	b.observe("SetTxDefaults", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.EstimateGas(args, blockNrOptional)
+	b.observe("EstimateGas", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.DoCall(args, blockNr, overrides)
+	b.observe("DoCall", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) (json.RawMessage, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.SimulateV1(opts, blockNr)
+	b.observe("SimulateV1", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*rpctypes.AccessListResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.CreateAccessList(args, blockNr)
+	b.observe("CreateAccessList", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GasPrice() (*hexutil.Big, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GasPrice()
+	b.observe("GasPrice", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetLogs(hash)
+	b.observe("GetLogs", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.GetLogsByHeight(height)
+	b.observe("GetLogsByHeight", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) FilterLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.FilterLogHeights(from, to, addresses, topics)
+	b.observe("FilterLogHeights", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.TraceTransaction(hash, config)
+	b.observe("TraceTransaction", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.TraceBlock(height, config, block)
+	b.observe("TraceBlock", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumberOrHash, config *rpctypes.TraceConfig) (interface{}, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.TraceCall(args, blockNr, config)
+	b.observe("TraceCall", start, err)
+	return res, err
+}
+
+func (b *metricsBackend) IntermediateRoots(block *tmrpctypes.ResultBlock) ([]common.Hash, error) {
+	start := time.Now()
+	res, err := b.EVMBackend.IntermediateRoots(block)
+	b.observe("IntermediateRoots", start, err)
+	return res, err
+}
@@ -0,0 +1,44 @@
+package backend
+
+import (
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Helios-Chain-Labs/ethermint/rpc/backend/mocks"
+)
+
+func (suite *BackendTestSuite) TestMetricsBackend() {
+	// the backend is returned as is when the metrics are disabled
+	suite.Require().Equal(EVMBackend(suite.backend), NewMetricsBackend(suite.backend, "eth"))
+
+	enabled := ethmetrics.Enabled
+	ethmetrics.Enabled = true
+	defer func() {
+		ethmetrics.Enabled = enabled
+	}()
+	b := NewMetricsBackend(suite.backend, "test")
+
+	var header metadata.MD
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParams(queryClient, &header, 1)
+	_, err := b.BlockNumber()
+	suite.Require().NoError(err)
+
+	suite.SetupTest()
+	queryClient = suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsInvalidHeader(queryClient, &header, 1)
+	b = NewMetricsBackend(suite.backend, "test")
+	_, err = b.BlockNumber()
+	suite.Require().Error(err)
+
+	timer, ok := ethmetrics.DefaultRegistry.Get("ethermint/rpc/backend/test/BlockNumber/duration").(ethmetrics.Timer)
+	suite.Require().True(ok)
+	suite.Require().Equal(int64(2), timer.Snapshot().Count())
+	counter, ok := ethmetrics.DefaultRegistry.Get("ethermint/rpc/backend/test/BlockNumber/errors").(ethmetrics.Counter)
+	suite.Require().True(ok)
+	suite.Require().Equal(int64(1), counter.Snapshot().Count())
+
+	// the calls which can't fail are not recorded
+	b.RPCGasCap()
+	suite.Require().Nil(ethmetrics.DefaultRegistry.Get("ethermint/rpc/backend/test/RPCGasCap/duration"))
+}
@@ -16,6 +16,7 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -88,6 +89,26 @@ func (b *Backend) Syncing() (interface{}, error) {
 	}, nil
 }
 
+// IndexerStatus returns the block range covered by the custom tx indexer and the block gaps it has skipped
+func (b *Backend) IndexerStatus() (*rpctypes.IndexerStatus, error) {
+	if b.indexer == nil {
+		return nil, errors.New("the custom tx indexer is not enabled")
+	}
+	first, err := b.indexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	last, err := b.indexer.LastIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	gaps, err := b.indexer.Gaps()
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.IndexerStatus{FirstIndexedBlock: first, LastIndexedBlock: last, Gaps: gaps}, nil
+}
+
 // SetEtherbase sets the etherbase of the miner
 func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 	delAddr, err := b.GetCoinbase()
@@ -122,7 +143,8 @@ func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 	denom := minGasPrices[0].Denom
 
 	delCommonAddr := common.BytesToAddress(delAddr.Bytes())
-	nonce, err := b.GetTransactionCount(delCommonAddr, rpctypes.EthPendingBlockNumber)
+	// the sequence of a cosmos tx accounts for all the mempool txs of the sender
+	nonce, err := b.getAccountNonce(delCommonAddr, true, 0, b.logger)
 	if err != nil {
 		b.logger.Debug("failed to get nonce", "error", err.Error())
 		return false
@@ -133,7 +155,7 @@ func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 		WithChainID(b.clientCtx.ChainID).
 		WithKeybase(b.clientCtx.Keyring).
 		WithTxConfig(b.clientCtx.TxConfig).
-		WithSequence(uint64(*nonce)).
+		WithSequence(nonce).
 		WithGasAdjustment(1.25)
 
 	_, gas, err := tx.CalculateGas(b.clientCtx, txFactory, msg)
@@ -12,10 +12,10 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/Helios-Chain-Labs/ethermint/crypto/ethsecp256k1"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
 	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 	"github.com/spf13/viper"
-	"google.golang.org/grpc/metadata"
 )
 
 func (suite *BackendTestSuite) TestRPCMinGasPrice() {
@@ -263,12 +263,10 @@ func (suite *BackendTestSuite) TestSetEtherbase() {
 		{
 			"fail - error querying for account ",
 			func() {
-				var header metadata.MD
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterStatus(client)
 				RegisterValidatorAccount(queryClient, suite.acc)
-				RegisterParams(queryClient, &header, 1)
 				c := sdk.NewDecCoin(types.DefaultEVMDenom, sdkmath.NewIntFromBigInt(big.NewInt(1)))
 				suite.backend.cfg.SetMinGasPrices(sdk.DecCoins{c})
 				delAddr, _ := suite.backend.GetCoinbase()
@@ -371,3 +369,56 @@ func (suite *BackendTestSuite) TestImportRawKey() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestIndexerStatus() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus *rpctypes.IndexerStatus
+		expPass   bool
+	}{
+		{
+			"fail - indexer disabled",
+			func() {
+				suite.backend.indexer = nil
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - empty indexer",
+			func() {},
+			&rpctypes.IndexerStatus{FirstIndexedBlock: -1, LastIndexedBlock: -1, Gaps: []ethermint.IndexerGap{}},
+			true,
+		},
+		{
+			"pass - gaps recorded",
+			func() {
+				suite.Require().NoError(suite.backend.indexer.RecordGap(3, 5))
+				suite.Require().NoError(suite.backend.indexer.RecordGap(6, 6))
+				suite.Require().NoError(suite.backend.indexer.RecordGap(10, 12))
+			},
+			&rpctypes.IndexerStatus{
+				FirstIndexedBlock: -1,
+				LastIndexedBlock:  -1,
+				Gaps:              []ethermint.IndexerGap{{From: 3, To: 6}, {From: 10, To: 12}},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			status, err := suite.backend.IndexerStatus()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expStatus, status)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
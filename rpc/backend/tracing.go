@@ -205,6 +205,46 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	return decodedResults, nil
 }
 
+// IntermediateRoots re-executes the ethereum transactions of the block on top of the
+// state of its parent, and returns the state fingerprint after each transaction. A failed
+// transaction doesn't change the state, its root is the one of the previous transaction.
+func (b *Backend) IntermediateRoots(block *tmrpctypes.ResultBlock) ([]common.Hash, error) {
+	txsMessages := b.EthMsgsFromTendermintBlock(block, nil)
+	if len(txsMessages) == 0 {
+		return []common.Hash{}, nil
+	}
+
+	// minus one to get the context at the beginning of the block
+	contextHeight := block.Block.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+	ctxWithHeight := rpctypes.ContextWithHeight(contextHeight)
+
+	res, err := b.queryClient.IntermediateRoots(ctxWithHeight, &evmtypes.QueryIntermediateRootsRequest{
+		Txs:             txsMessages,
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Hash, len(res.Roots))
+	for i, root := range res.Roots {
+		roots[i] = common.HexToHash(root)
+		// the failed transactions keep the root of the previous one
+		if i < len(res.Errors) && res.Errors[i] != "" {
+			b.logger.Debug("intermediate root of failed tx", "height", block.Block.Height, "index", i, "error", res.Errors[i])
+		}
+	}
+	return roots, nil
+}
+
 // TraceCall returns the structured logs created during the execution of EVM call
 // and returns them as a JSON object.
 func (b *Backend) TraceCall(
@@ -201,7 +201,7 @@ func (suite *BackendTestSuite) TestTraceTransaction() {
 			db := dbm.NewMemDB()
 			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
 
-			err := suite.backend.indexer.IndexBlock(tc.block, tc.responseBlock)
+			err := suite.backend.indexer.IndexBlock(tc.block, tc.responseBlock, nil)
 			suite.Require().NoError(err)
 			txResult, err := suite.backend.TraceTransaction(txHash, nil)
 
@@ -17,6 +17,7 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -25,6 +26,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
@@ -131,6 +133,53 @@ func (b *Backend) getTransactionByHashPending(txHash common.Hash) (*rpctypes.RPC
 	return nil, nil
 }
 
+// GetRawTransactionByHash returns the canonical encoding of the ethereum tx identified by hash, the tx is
+// searched in the mempool if it's not included in a block yet.
+func (b *Backend) GetRawTransactionByHash(txHash common.Hash) (hexutil.Bytes, error) {
+	msg, err := b.getEthMsgByHash(txHash)
+	if err != nil || msg == nil {
+		return nil, err
+	}
+	return msg.MarshalBinary()
+}
+
+// getEthMsgByHash returns the ethereum tx identified by hash from the blocks or the mempool,
+// returns nil if not found.
+func (b *Backend) getEthMsgByHash(txHash common.Hash) (*evmtypes.MsgEthereumTx, error) {
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		txs, err := b.PendingTransactions()
+		if err != nil {
+			b.logger.Debug("tx not found", "hash", txHash, "error", err.Error())
+			return nil, nil
+		}
+		for _, tx := range txs {
+			if msg, err := evmtypes.UnwrapEthereumMsg(tx, txHash); err == nil {
+				return msg, nil
+			}
+		}
+		b.logger.Debug("tx not found", "hash", txHash)
+		return nil, nil
+	}
+
+	block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
+	msg, ok := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, errors.New("invalid ethereum tx")
+	}
+	return msg, nil
+}
+
 // GetGasUsed returns gasUsed from transaction
 func (b *Backend) GetGasUsed(res *ethermint.TxResult, gas uint64) uint64 {
 	// patch gasUsed if tx is reverted and happened before height on which fixed was introduced
@@ -151,6 +200,9 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		b.logger.Debug("tx not found", "hash", hash, "error", err.Error())
 		return nil, nil
 	}
+	if res.Receipt != nil {
+		return b.formatIndexedReceipt(hash, res), nil
+	}
 	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
 	if err != nil {
 		b.logger.Debug("block not found", "height", res.Height, "error", err.Error())
@@ -180,18 +232,187 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	cumulativeGasUsed += res.CumulativeGasUsed
 
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash() == hash {
+				res.EthTxIndex = int32(i)
+				break
+			}
+		}
+	}
+	// return error if still unable to find the eth tx index
+	if res.EthTxIndex == -1 {
+		return nil, errors.New("can't find index of ethereum tx")
+	}
+
+	var baseFee *big.Int
+	if txData.Type() == ethtypes.DynamicFeeTxType || txData.Type() == ethtypes.BlobTxType {
+		baseFee, err = b.BaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		}
+	}
+
+	return b.formatTxReceipt(
+		ethMsg, res, blockRes, common.BytesToHash(resBlock.Block.Header.Hash()), cumulativeGasUsed,
+		baseFee, ethtypes.LatestSignerForChainID(chainID.ToInt()),
+	)
+}
+
+// GetBlockReceipts returns the receipts of all the ethereum transactions in the block, they are built from a
+// single query of the block results instead of one per transaction.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "height", blockNum)
+		return nil, nil
+	}
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Warn("failed to retrieve block results", "height", height, "error", err.Error())
+		return nil, nil
+	}
+	if len(blockRes.TxsResults) != len(resBlock.Block.Txs) {
+		return nil, fmt.Errorf("block results don't match the txs of block %d", height)
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	signer := ethtypes.LatestSignerForChainID(chainID.ToInt())
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", height, "error", err)
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipts := make([]map[string]interface{}, 0, len(resBlock.Block.Txs))
+
+	// the gas used by the cosmos txs before the current one
+	var blockGasUsed uint64
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, txBz := range resBlock.Block.Txs {
+		result := blockRes.TxsResults[txIndex]
+		prevGasUsed := blockGasUsed
+		blockGasUsed += uint64(result.GasUsed)
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse tx result", "height", height, "txIndex", txIndex, "error", err.Error())
+			continue
+		}
+
+		var txGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			res := &ethermint.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),
+				MsgIndex:   uint32(msgIndex),
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK && result.Codespace != evmtypes.ModuleName {
+				// exceeds block gas limit scenario, the gas limit is charged by ante handler.
+				res.GasUsed = ethMsg.GetGas()
+				res.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					b.logger.Debug("msg index not found in results", "height", height, "msgIndex", msgIndex)
+					continue
+				}
+				res.GasUsed = parsedTx.GasUsed
+				res.Failed = parsedTx.Failed
+			}
+			txGasUsed += res.GasUsed
+			res.CumulativeGasUsed = txGasUsed
+			ethTxIndex++
+
+			receipt, err := b.formatTxReceipt(ethMsg, res, blockRes, blockHash, prevGasUsed+txGasUsed, baseFee, signer)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+	}
+	return receipts, nil
+}
+
+// GetRawReceipts returns the consensus encoding of the receipts of all the ethereum transactions in the block.
+func (b *Backend) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	receipts, err := b.GetBlockReceipts(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]hexutil.Bytes, len(receipts))
+	for i, fields := range receipts {
+		receipt := &ethtypes.Receipt{
+			Type:              uint8(fields["type"].(hexutil.Uint)),
+			Status:            uint64(fields["status"].(hexutil.Uint)),
+			CumulativeGasUsed: uint64(fields["cumulativeGasUsed"].(hexutil.Uint64)),
+			Bloom:             fields["logsBloom"].(ethtypes.Bloom),
+		}
+		if logs, ok := fields["logs"].([]*ethtypes.Log); ok {
+			receipt.Logs = logs
+		}
+		result[i], err = receipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// formatTxReceipt returns the receipt of the ethereum tx of the indexed result, the cumulative gas used includes
+// the gas used by the tx.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *ethermint.TxResult,
+	blockRes *tmrpctypes.ResultBlockResults,
+	blockHash common.Hash,
+	cumulativeGasUsed uint64,
+	baseFee *big.Int,
+	signer ethtypes.Signer,
+) (map[string]interface{}, error) {
+	txData := ethMsg.AsTransaction()
+	hash := txData.Hash()
+
 	var status hexutil.Uint
 	if res.Failed {
 		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
 	} else {
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
 	}
-	chainID, err := b.ChainID()
-	if err != nil {
-		return nil, err
-	}
 
-	from, err := ethMsg.GetSenderLegacy(ethtypes.LatestSignerForChainID(chainID.ToInt()))
+	from, err := ethMsg.GetSenderLegacy(signer)
 	if err != nil {
 		return nil, err
 	}
@@ -207,21 +428,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		b.logger.Warn("failed to parse logs", "hash", hash, "error", err.Error())
 	}
 
-	if res.EthTxIndex == -1 {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash() == hash {
-				res.EthTxIndex = int32(i)
-				break
-			}
-		}
-	}
-	// return error if still unable to find the eth tx index
-	if res.EthTxIndex == -1 {
-		return nil, errors.New("can't find index of ethereum tx")
-	}
-
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
@@ -237,14 +443,14 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
 
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.To(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(ethMsg.TxType()),
 	}
 
 	if logs == nil {
@@ -256,19 +462,81 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.Nonce())
 	}
 
-	if txData.Type() == ethtypes.DynamicFeeTxType {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*ethMsg.GetEffectiveGasPrice(baseFee))
-		}
+	if txData.Type() == ethtypes.BlobTxType {
+		receipt["blobGasUsed"] = hexutil.Uint64(txData.BlobGas())
+		receipt["blobGasPrice"] = (*hexutil.Big)(eip4844.CalcBlobFee(0))
+	}
+
+	switch {
+	case baseFee != nil:
+		receipt["effectiveGasPrice"] = hexutil.Big(*ethMsg.GetEffectiveGasPrice(baseFee))
+	case txData.Type() == ethtypes.LegacyTxType || txData.Type() == ethtypes.AccessListTxType:
+		// the gas price is paid regardless of the base fee
+		receipt["effectiveGasPrice"] = hexutil.Big(*txData.GasPrice())
 	}
 
 	return receipt, nil
 }
 
+// formatIndexedReceipt returns the receipt of the ethereum tx from the full receipt stored by the indexer, neither
+// the block nor the block results are queried.
+func (b *Backend) formatIndexedReceipt(hash common.Hash, res *ethermint.TxResult) map[string]interface{} {
+	indexed := res.Receipt
+
+	var status hexutil.Uint
+	if res.Failed {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	}
+
+	var to *common.Address
+	if len(indexed.To) > 0 {
+		addr := common.BytesToAddress(indexed.To)
+		to = &addr
+	}
+
+	receipt := map[string]interface{}{
+		// Consensus fields: These fields are defined by the Yellow Paper
+		"status":            status,
+		"cumulativeGasUsed": hexutil.Uint64(indexed.BlockCumulativeGasUsed),
+		"logsBloom":         ethtypes.BytesToBloom(indexed.Bloom),
+		"logs":              indexed.EthLogs(res, hash),
+
+		// Implementation fields: These fields are added by geth when processing a transaction.
+		// They are stored in the chain database.
+		"transactionHash": hash,
+		"contractAddress": nil,
+		"gasUsed":         hexutil.Uint64(b.GetGasUsed(res, indexed.GasLimit)),
+
+		// Inclusion information: These fields provide information about the inclusion of the
+		// transaction corresponding to this receipt.
+		"blockHash":        common.BytesToHash(indexed.BlockHash).Hex(),
+		"blockNumber":      hexutil.Uint64(res.Height),
+		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
+
+		// sender and receiver (contract or EOA) addreses
+		"from": common.BytesToAddress(indexed.From),
+		"to":   to,
+		"type": hexutil.Uint(indexed.TxType),
+	}
+
+	if len(indexed.ContractAddress) > 0 {
+		receipt["contractAddress"] = common.BytesToAddress(indexed.ContractAddress)
+	}
+
+	if indexed.TxType == ethtypes.BlobTxType {
+		receipt["blobGasUsed"] = hexutil.Uint64(indexed.BlobGasUsed)
+		receipt["blobGasPrice"] = (*hexutil.Big)(eip4844.CalcBlobFee(0))
+	}
+
+	if price, ok := new(big.Int).SetString(indexed.EffectiveGasPrice, 10); ok {
+		receipt["effectiveGasPrice"] = hexutil.Big(*price)
+	}
+
+	return receipt
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
@@ -310,6 +578,32 @@ func (b *Backend) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNum
 	return b.GetTransactionByBlockAndIndex(block, idx)
 }
 
+// GetRawTransactionByBlockNumberAndIndex returns the canonical encoding of the ethereum tx identified by
+// number and index.
+func (b *Backend) GetRawTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (hexutil.Bytes, error) {
+	block, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum.Int64(), "error", err.Error())
+		return nil, nil
+	}
+
+	if block.Block == nil {
+		b.logger.Debug("block not found", "height", blockNum.Int64())
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		return nil, nil
+	}
+
+	msg := b.getEthMsgByBlockAndIndex(block, blockRes, idx)
+	if msg == nil {
+		return nil, nil
+	}
+	return msg.MarshalBinary()
+}
+
 // GetTxByEthHash uses `/tx_query` to find transaction by ethereum tx hash
 // TODO: Don't need to convert once hashing is fixed on Tendermint
 // https://github.com/tendermint/tendermint/issues/6539
@@ -386,6 +680,34 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		return nil, nil
 	}
 
+	msg := b.getEthMsgByBlockAndIndex(block, blockRes, idx)
+	if msg == nil {
+		return nil, nil
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// handle the error for pruned node.
+		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", block.Block.Height, "error", err)
+	}
+
+	return rpctypes.NewTransactionFromMsg(
+		msg,
+		common.BytesToHash(block.Block.Hash()),
+		uint64(block.Block.Height),
+		uint64(idx),
+		baseFee,
+		b.chainID,
+	)
+}
+
+// getEthMsgByBlockAndIndex returns the ethereum tx at the index of the valid ethereum txs in the block,
+// returns nil if not found.
+func (b *Backend) getEthMsgByBlockAndIndex(
+	block *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	idx hexutil.Uint,
+) *evmtypes.MsgEthereumTx {
 	var msg *evmtypes.MsgEthereumTx
 	// find in tx indexer
 	res, err := b.GetTxByTxIndex(block.Block.Height, uint(idx))
@@ -393,7 +715,7 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		tx, err := b.clientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
 		if err != nil {
 			b.logger.Warn("invalid ethereum tx", "height", block.Block.Header, "index", idx)
-			return nil, nil
+			return nil
 		}
 
 		var ok bool
@@ -401,31 +723,18 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		msg, ok = tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 		if !ok {
 			b.logger.Warn("invalid ethereum tx", "height", block.Block.Header, "index", idx)
-			return nil, nil
+			return nil
 		}
 	} else {
 		i := int(idx)
 		ethMsgs := b.EthMsgsFromTendermintBlock(block, blockRes)
 		if i >= len(ethMsgs) {
 			b.logger.Warn("block txs index out of bound", "index", i)
-			return nil, nil
+			return nil
 		}
 
 		msg = ethMsgs[i]
 	}
 
-	baseFee, err := b.BaseFee(blockRes)
-	if err != nil {
-		// handle the error for pruned node.
-		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", block.Block.Height, "error", err)
-	}
-
-	return rpctypes.NewTransactionFromMsg(
-		msg,
-		common.BytesToHash(block.Block.Hash()),
-		uint64(block.Block.Height),
-		uint64(idx),
-		baseFee,
-		b.chainID,
-	)
+	return msg
 }
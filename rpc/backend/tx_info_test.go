@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -12,6 +13,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/Helios-Chain-Labs/ethermint/indexer"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
@@ -106,7 +108,7 @@ func (suite *BackendTestSuite) TestGetTransactionByHash() {
 
 			db := dbm.NewMemDB()
 			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
-			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver, nil)
 			suite.Require().NoError(err)
 
 			rpcTx, err := suite.backend.GetTransactionByHash(tc.tx.Hash())
@@ -181,6 +183,77 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetRawTransactionByHash() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgEthereumTx.Hash().Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+	expRaw, err := msgEthereumTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		indexed      bool
+		expRaw       hexutil.Bytes
+	}{
+		{
+			"pass - tx found in block",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+			},
+			true,
+			expRaw,
+		},
+		{
+			"pass - tx found in mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{txBz})
+			},
+			false,
+			expRaw,
+		},
+		{
+			"pass - tx not found return nil",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			if tc.indexed {
+				suite.Require().NoError(suite.backend.indexer.IndexBlock(block, responseDeliver, nil))
+			}
+
+			raw, err := suite.backend.GetRawTransactionByHash(msgEthereumTx.Hash())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRaw, raw)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetTxByEthHash() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 	rpcTransaction, _ := rpctypes.NewRPCTransaction(msgEthereumTx, common.Hash{}, 0, 0, big.NewInt(1), suite.backend.chainID)
@@ -342,7 +415,7 @@ func (suite *BackendTestSuite) TestGetTransactionByBlockAndIndex() {
 				suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
 				txBz := suite.signAndEncodeEthTx(msgEthTx)
 				block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
-				err := suite.backend.indexer.IndexBlock(block, defaultResponseDeliverTx)
+				err := suite.backend.indexer.IndexBlock(block, defaultResponseDeliverTx, nil)
 				suite.Require().NoError(err)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, sdkmath.NewInt(1))
@@ -446,6 +519,60 @@ func (suite *BackendTestSuite) TestGetTransactionByBlockNumberAndIndex() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetRawTransactionByBlockNumberAndIndex() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	expRaw, err := msgEthTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		idx          hexutil.Uint
+		expRaw       hexutil.Bytes
+	}{
+		{
+			"fail - block not found return nil",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			0,
+			nil,
+		},
+		{
+			"fail - index out of bound return nil",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, bz)
+				RegisterBlockResults(client, 1)
+			},
+			1,
+			nil,
+		},
+		{
+			"pass - returns the transaction identified by block number and index",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, bz)
+				RegisterBlockResults(client, 1)
+			},
+			0,
+			expRaw,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			raw, err := suite.backend.GetRawTransactionByBlockNumberAndIndex(1, tc.idx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRaw, raw)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetTransactionByTxIndex() {
 	_, bz := suite.buildEthereumTx()
 
@@ -583,7 +710,7 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 
 			db := dbm.NewMemDB()
 			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
-			err := suite.backend.indexer.IndexBlock(tc.block, tc.blockResult)
+			err := suite.backend.indexer.IndexBlock(tc.block, tc.blockResult, nil)
 			suite.Require().NoError(err)
 
 			txReceipt, err := suite.backend.GetTransactionReceipt(tc.tx.Hash())
@@ -597,6 +724,179 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetTransactionReceiptFromIndexer() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.Hash()
+	logAddress := common.BigToAddress(big.NewInt(10))
+	logBz, err := json.Marshal(evmtypes.NewLogFromEth(&ethtypes.Log{Address: logAddress, Data: []byte{1}}))
+	suite.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxLog, Value: string(logBz)},
+				}},
+			},
+		},
+	}
+	blockHash := common.BytesToHash(block.Hash())
+	logs := []*ethtypes.Log{{
+		Address:     logAddress,
+		Topics:      []common.Hash{},
+		Data:        []byte{1},
+		BlockNumber: 1,
+		TxHash:      txHash,
+		BlockHash:   blockHash,
+	}}
+
+	suite.SetupTest() // reset
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+	idxer.SetFullReceipts(true)
+	suite.backend.indexer = idxer
+	suite.Require().NoError(idxer.IndexBlock(block, blockResult, nil))
+
+	// neither the block nor the block results are queried
+	receipt, err := suite.backend.GetTransactionReceipt(txHash)
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]interface{}{
+		"status":            hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+		"cumulativeGasUsed": hexutil.Uint64(21000),
+		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		"logs":              logs,
+		"transactionHash":   txHash,
+		"contractAddress":   nil,
+		"gasUsed":           hexutil.Uint64(21000),
+		"blockHash":         blockHash.Hex(),
+		"blockNumber":       hexutil.Uint64(1),
+		"transactionIndex":  hexutil.Uint64(0),
+		"from":              common.BytesToAddress(msgEthereumTx.From),
+		"to":                &common.Address{},
+		"type":              hexutil.Uint(ethtypes.LegacyTxType),
+		"effectiveGasPrice": hexutil.Big(*big.NewInt(1)),
+	}, receipt)
+}
+
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	msgEthereumTx2 := evmtypes.NewTx(suite.backend.chainID, 1, &common.Address{}, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+	txBz2 := suite.signAndEncodeEthTx(msgEthereumTx2)
+
+	txResult := func(hash common.Hash, index int, gasUsed int64) *abci.ExecTxResult {
+		return &abci.ExecTxResult{
+			Code:    0,
+			GasUsed: gasUsed,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: hash.Hex()},
+					{Key: "txIndex", Value: fmt.Sprint(index)},
+					{Key: "txGasUsed", Value: fmt.Sprint(gasUsed)},
+				}},
+			},
+		}
+	}
+	blockNum := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expHashes    []common.Hash
+		expCumulated []hexutil.Uint64
+	}{
+		{
+			"fail - block results error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+				RegisterBlockResultsError(client, 1)
+			},
+			nil,
+			nil,
+		},
+		{
+			"pass - receipts of all the txs",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1))
+				RegisterBlockMultipleTxs(client, 1, []types.Tx{txBz, txBz2})
+				RegisterBlockResultsWithTxResults(client, 1, []*abci.ExecTxResult{
+					txResult(msgEthereumTx.AsTransaction().Hash(), 0, 21000),
+					txResult(msgEthereumTx2.AsTransaction().Hash(), 1, 30000),
+				})
+			},
+			[]common.Hash{msgEthereumTx.AsTransaction().Hash(), msgEthereumTx2.AsTransaction().Hash()},
+			[]hexutil.Uint64{21000, 51000},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			suite.Require().NoError(err)
+			suite.Require().Len(receipts, len(tc.expHashes))
+			for i, receipt := range receipts {
+				suite.Require().Equal(tc.expHashes[i], receipt["transactionHash"])
+				suite.Require().Equal(tc.expCumulated[i], receipt["cumulativeGasUsed"])
+				suite.Require().Equal(hexutil.Uint64(i), receipt["transactionIndex"])
+				suite.Require().Equal(hexutil.Big(*big.NewInt(1)), receipt["effectiveGasPrice"])
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	blockNum := rpctypes.BlockNumber(1)
+
+	var header metadata.MD
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterParams(queryClient, &header, 1)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	RegisterBaseFee(queryClient, sdkmath.NewInt(1))
+	RegisterBlock(client, 1, txBz)
+	RegisterBlockResultsWithTxResults(client, 1, []*abci.ExecTxResult{{
+		Code:    0,
+		GasUsed: 21000,
+		Events: []abci.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: msgEthereumTx.AsTransaction().Hash().Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+			}},
+		},
+	}})
+
+	raws, err := suite.backend.GetRawReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	suite.Require().NoError(err)
+	suite.Require().Len(raws, 1)
+
+	var receipt ethtypes.Receipt
+	suite.Require().NoError(receipt.UnmarshalBinary(raws[0]))
+	suite.Require().Equal(msgEthereumTx.AsTransaction().Type(), receipt.Type)
+	suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	suite.Require().Equal(uint64(21000), receipt.CumulativeGasUsed)
+	suite.Require().Empty(receipt.Logs)
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -0,0 +1,116 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// TxPoolContent returns the ethereum transactions of the mempool grouped by sender and nonce.
+// Following geth semantics, the transactions whose nonces form a contiguous sequence starting
+// at the committed account nonce are returned as pending, while the rest are returned as queued.
+func (b *Backend) TxPoolContent() (rpctypes.TxPoolTransactions, rpctypes.TxPoolTransactions, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(b.chainID)
+	all := make(rpctypes.TxPoolTransactions)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSenderLegacy(signer)
+			if err != nil {
+				b.logger.Debug("failed to recover sender of pending tx", "hash", ethMsg.Hash(), "error", err.Error())
+				continue
+			}
+
+			rpctx, err := rpctypes.NewTransactionFromMsg(
+				ethMsg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				nil,
+				b.chainID,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if _, ok := all[sender]; !ok {
+				all[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			all[sender][uint64(rpctx.Nonce)] = rpctx
+		}
+	}
+
+	pending := make(rpctypes.TxPoolTransactions)
+	queued := make(rpctypes.TxPoolTransactions)
+	for sender, byNonce := range all {
+		nonce, err := b.committedNonce(sender)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// move the executable sequence to pending, anything after a nonce gap is queued
+		for {
+			rpctx, ok := byNonce[nonce]
+			if !ok {
+				break
+			}
+			if _, ok := pending[sender]; !ok {
+				pending[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			pending[sender][nonce] = rpctx
+			delete(byNonce, nonce)
+			nonce++
+		}
+
+		if len(byNonce) > 0 {
+			queued[sender] = byNonce
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// committedNonce returns the sequence of the given account at the latest committed state,
+// or zero if the account doesn't exist yet.
+func (b *Backend) committedNonce(address common.Address) (uint64, error) {
+	_, seq, err := b.clientCtx.AccountRetriever.GetAccountNumberSequence(b.clientCtx, sdk.AccAddress(address.Bytes()))
+	if err != nil {
+		st, ok := status.FromError(err)
+		// treat as account doesn't exist yet
+		if ok && st.Code() == codes.NotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return seq, nil
+}
@@ -0,0 +1,175 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Helios-Chain-Labs/ethermint/rpc/backend/mocks"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// buildEthereumTxWithNonce returns the encoded cosmos tx of a signed legacy Ethereum transaction
+func (suite *BackendTestSuite) buildEthereumTxWithNonce(nonce uint64) []byte {
+	msgEthereumTx := evmtypes.NewTx(
+		suite.backend.chainID,
+		nonce,
+		&common.Address{},
+		big.NewInt(0),
+		100000,
+		big.NewInt(1),
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	msgEthereumTx.From = suite.signerAddress
+	err := msgEthereumTx.Sign(ethtypes.LatestSignerForChainID(suite.backend.chainID), suite.signer)
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return bz
+}
+
+// accountRetrieverError is an account retriever failing with the given error.
+type accountRetrieverError struct {
+	client.TestAccountRetriever
+	err error
+}
+
+func (r accountRetrieverError) GetAccountNumberSequence(client.Context, sdk.AccAddress) (uint64, uint64, error) {
+	return 0, 0, r.err
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	signerAccount := func(seq uint64) client.AccountRetriever {
+		return client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+			suite.signerAddress.String(): {Address: suite.signerAddress, Seq: seq},
+		}}
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		retriever    func() client.AccountRetriever
+		expPending   []uint64
+		expQueued    []uint64
+		expPass      bool
+	}{
+		{
+			"fail - unconfirmed txs error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			func() client.AccountRetriever { return signerAccount(0) },
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - account query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, []types.Tx{suite.buildEthereumTxWithNonce(0)})
+			},
+			func() client.AccountRetriever {
+				return accountRetrieverError{err: errors.New("connection refused")}
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			func() client.AccountRetriever { return signerAccount(0) },
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - new account starts at nonce 0",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, []types.Tx{suite.buildEthereumTxWithNonce(0)})
+			},
+			func() client.AccountRetriever {
+				return accountRetrieverError{err: status.Error(codes.NotFound, "account not found")}
+			},
+			[]uint64{0},
+			nil,
+			true,
+		},
+		{
+			"pass - contiguous nonces are pending",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, []types.Tx{
+					suite.buildEthereumTxWithNonce(1),
+					suite.buildEthereumTxWithNonce(0),
+				})
+			},
+			func() client.AccountRetriever { return signerAccount(0) },
+			[]uint64{0, 1},
+			nil,
+			true,
+		},
+		{
+			"pass - nonce gap is queued",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, []types.Tx{
+					suite.buildEthereumTxWithNonce(0),
+					suite.buildEthereumTxWithNonce(2),
+					suite.buildEthereumTxWithNonce(3),
+				})
+			},
+			func() client.AccountRetriever { return signerAccount(0) },
+			[]uint64{0},
+			[]uint64{2, 3},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+			suite.backend.clientCtx = suite.backend.clientCtx.WithAccountRetriever(tc.retriever())
+			sender := common.BytesToAddress(suite.signerAddress)
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().Len(pending[sender], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				suite.Require().Contains(pending[sender], nonce)
+				suite.Require().Equal(sender, pending[sender][nonce].From)
+			}
+			suite.Require().Len(queued[sender], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				suite.Require().Contains(queued[sender], nonce)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -118,6 +119,90 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// pendingQueryContext returns the context of a state query at the given block. The queries of
+// the pending block re-execute the mempool transactions, so they are bound by the evm timeout.
+func (b *Backend) pendingQueryContext(blockNum types.BlockNumber) (context.Context, context.CancelFunc) {
+	ctx := types.ContextWithHeight(blockNum.Int64())
+	if timeout := b.RPCEVMTimeout(); blockNum == types.EthPendingBlockNumber && timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return ctx, func() {}
+}
+
+// pendingEthTxs returns the ethereum transactions of the mempool to apply on top of the latest
+// state when the pending block is queried, it returns nil for any other block.
+// The transactions of each sender are ordered by nonce, while the mempool order is kept across
+// the senders. Since they are re-executed by each pending query, only the first
+// PendingTxsMaxCount transactions are returned, and their gas limits can't exceed the gas cap.
+func (b *Backend) pendingEthTxs(blockNum types.BlockNumber) []*evmtypes.MsgEthereumTx {
+	if blockNum != types.EthPendingBlockNumber {
+		return nil
+	}
+
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		b.logger.Error("failed to fetch pending transactions", "error", err.Error())
+		return nil
+	}
+
+	signer := ethtypes.LatestSignerForChainID(b.chainID)
+	var (
+		result    []*evmtypes.MsgEthereumTx
+		senders   []common.Address
+		positions = make(map[common.Address][]int)
+	)
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSenderLegacy(signer)
+			if err != nil {
+				b.logger.Debug("failed to recover sender of pending tx", "hash", ethMsg.Hash(), "error", err.Error())
+				continue
+			}
+
+			if _, ok := positions[sender]; !ok {
+				senders = append(senders, sender)
+			}
+			positions[sender] = append(positions[sender], len(result))
+			result = append(result, ethMsg)
+		}
+	}
+
+	// reorder the transactions of each sender by nonce, within the positions they take in the mempool
+	for _, sender := range senders {
+		txs := make([]*evmtypes.MsgEthereumTx, len(positions[sender]))
+		for i, pos := range positions[sender] {
+			txs[i] = result[pos]
+		}
+		sort.SliceStable(txs, func(i, j int) bool {
+			return txs[i].AsTransaction().Nonce() < txs[j].AsTransaction().Nonce()
+		})
+		for i, pos := range positions[sender] {
+			result[pos] = txs[i]
+		}
+	}
+
+	if len(result) > evmtypes.PendingTxsMaxCount {
+		result = result[:evmtypes.PendingTxsMaxCount]
+	}
+	if gasCap := b.RPCGasCap(); gasCap > 0 {
+		var gasLimit uint64
+		for i, tx := range result {
+			var overflow bool
+			if gasLimit, overflow = math.SafeAdd(gasLimit, tx.GetGas()); overflow || gasLimit > gasCap {
+				result = result[:i]
+				break
+			}
+		}
+	}
+	return result
+}
+
 // CalcBaseFee calculates the basefee of the header.
 func CalcBaseFee(config *params.ChainConfig, parent *ethtypes.Header, p feemarkettypes.Params) (*big.Int, error) {
 	// If the current block is the first EIP-1559 block, return the InitialBaseFee.
@@ -2,8 +2,17 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Helios-Chain-Labs/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	"github.com/Helios-Chain-Labs/ethermint/tests"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 )
 
 func mookProofs(num int, withData bool) *crypto.ProofOps {
@@ -50,3 +59,115 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestPendingEthTxs() {
+	// a transaction of another sender sits between the out of order transactions of the signer
+	otherMsg := evmtypes.NewTx(suite.backend.chainID, 0, &common.Address{}, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+	otherFrom, otherPriv := tests.NewAddrKey()
+	otherMsg.From = otherFrom.Bytes()
+	suite.Require().NoError(otherMsg.Sign(ethtypes.LatestSignerForChainID(suite.backend.chainID), tests.NewSigner(otherPriv)))
+	otherTx, err := otherMsg.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	suite.Require().NoError(err)
+	otherBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(otherTx)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		blockNum     rpctypes.BlockNumber
+		registerMock func()
+		expNonces    []uint64
+		expOther     int
+	}{
+		{
+			"latest block doesn't query the mempool",
+			rpctypes.EthLatestBlockNumber,
+			func() {},
+			nil,
+			-1,
+		},
+		{
+			"mempool error",
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			nil,
+			-1,
+		},
+		{
+			"txs of each sender are ordered by nonce",
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, tmtypes.Txs{
+					suite.buildEthereumTxWithNonce(2),
+					otherBz,
+					suite.buildEthereumTxWithNonce(0),
+					suite.buildEthereumTxWithNonce(1),
+				})
+			},
+			[]uint64{0, 1, 2},
+			1,
+		},
+		{
+			"txs over the gas cap are dropped",
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				suite.backend.cfg.JSONRPC.GasCap = 250000
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, tmtypes.Txs{
+					suite.buildEthereumTxWithNonce(2),
+					otherBz,
+					suite.buildEthereumTxWithNonce(0),
+					suite.buildEthereumTxWithNonce(1),
+				})
+			},
+			[]uint64{0},
+			1,
+		},
+		{
+			"txs over the max count are dropped",
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				suite.backend.cfg.JSONRPC.GasCap = 0
+				txs := make(tmtypes.Txs, evmtypes.PendingTxsMaxCount+1)
+				for i := range txs {
+					txs[i] = suite.buildEthereumTxWithNonce(uint64(i))
+				}
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, txs)
+			},
+			func() []uint64 {
+				nonces := make([]uint64, evmtypes.PendingTxsMaxCount)
+				for i := range nonces {
+					nonces[i] = uint64(i)
+				}
+				return nonces
+			}(),
+			-1,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			txs := suite.backend.pendingEthTxs(tc.blockNum)
+			if tc.expNonces == nil {
+				suite.Require().Nil(txs)
+				return
+			}
+
+			var nonces []uint64
+			for i, tx := range txs {
+				if i == tc.expOther {
+					suite.Require().Equal(otherMsg.Hash(), tx.Hash())
+					continue
+				}
+				nonces = append(nonces, tx.AsTransaction().Nonce())
+			}
+			suite.Require().Equal(tc.expNonces, nonces)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	"github.com/Helios-Chain-Labs/ethermint/server/config"
+)
+
+const (
+	// errCodeResponseTooLarge and errMsgResponseTooLarge are the JSON-RPC error of the requests
+	// dropped because of the batch response limit, same as geth.
+	errCodeResponseTooLarge = -32003
+	errMsgResponseTooLarge  = "response too large"
+)
+
+var (
+	// readOnlyPrefixes are the prefixes of the methods which don't change the state of the node,
+	// so they can be served in any order.
+	readOnlyPrefixes = []string{"eth_get", "trace_", "debug_trace", "debug_get", "txpool_", "net_", "web3_"}
+	// readOnlyMethods are the other methods which don't change the state of the node.
+	readOnlyMethods = map[string]bool{
+		"eth_blockNumber":          true,
+		"eth_call":                 true,
+		"eth_chainId":              true,
+		"eth_estimateGas":          true,
+		"eth_feeHistory":           true,
+		"eth_gasPrice":             true,
+		"eth_maxPriorityFeePerGas": true,
+		"eth_syncing":              true,
+	}
+	// stateMethods are the methods matching the read-only prefixes which change the state of the node,
+	// eth_getFilterChanges moves the cursor of the filter.
+	stateMethods = map[string]bool{
+		"eth_getFilterChanges": true,
+	}
+)
+
+// Handler is a http middleware of the JSON-RPC server which serves the read-only requests of the
+// batches on a bounded worker pool shared by all of them. The other requests are served alone, once
+// the previous ones of the batch are done, so the order of the state changes is kept. It also limits
+// the size of the responses of a batch, the batches with too many requests are passed as is to the
+// JSON-RPC server which rejects them.
+type Handler struct {
+	next            http.Handler
+	requestLimit    int
+	responseMaxSize int
+	// workers holds a slot for each read-only request being served
+	workers chan struct{}
+}
+
+// NewHandler creates a batch handler in front of the given JSON-RPC handler.
+func NewHandler(next http.Handler, cfg config.JSONRPCConfig) *Handler {
+	return &Handler{
+		next:            next,
+		requestLimit:    cfg.BatchRequestLimit,
+		responseMaxSize: cfg.BatchResponseMaxSize,
+		workers:         make(chan struct{}, cfg.BatchConcurrency),
+	}
+}
+
+// Enabled returns true if the batches are served concurrently, otherwise the JSON-RPC server
+// serves them sequentially and applies the batch limits itself.
+func Enabled(cfg config.JSONRPCConfig) bool {
+	return cfg.BatchConcurrency > 1
+}
+
+// result is the response of a request of the batch.
+type result struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (r *result) Header() http.Header {
+	return r.header
+}
+
+func (r *result) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+}
+
+func (r *result) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(b)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r, body, err := rpctypes.ReadJSONRPCBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the malformed and the rejected requests are served as is by the JSON-RPC server
+	raws, reqs := body.BatchRaws, body.Requests
+	if body.TooLarge || !body.Batch || len(raws) == 0 || (h.requestLimit > 0 && len(raws) > h.requestLimit) {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	results := h.serveBatch(r, raws, reqs)
+	for _, res := range results {
+		// the requests rejected by the limits have a JSON-RPC error response
+		if res != nil && res.code != 0 && res.code != http.StatusOK && res.code != http.StatusTooManyRequests {
+			// the request itself is rejected, e.g. with a wrong content type
+			for k, v := range res.header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(res.code)
+			_, _ = w.Write(res.body.Bytes())
+			return
+		}
+	}
+
+	responses := make([]json.RawMessage, 0, len(results))
+	size := 0
+	for i, res := range results {
+		var resp []byte
+		if res != nil {
+			resp = bytes.TrimSpace(res.body.Bytes())
+		}
+		if (res != nil && len(resp) == 0) || (res == nil && len(reqs[i].ID) == 0) {
+			// notification
+			continue
+		}
+		if res == nil || (h.responseMaxSize > 0 && size > h.responseMaxSize) {
+			responses = append(responses, responseTooLarge(reqs[i].ID))
+			continue
+		}
+		size += len(resp)
+		responses = append(responses, resp)
+	}
+	if len(responses) == 0 {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(responses)
+}
+
+// serveBatch serves the requests of the batch with the next handler and returns their responses
+// in order. The requests left once the response size limit is exceeded are not served, their
+// response is nil.
+func (h *Handler) serveBatch(r *http.Request, raws []json.RawMessage, reqs []rpctypes.JSONRPCRequest) []*result {
+	var (
+		results = make([]*result, len(raws))
+		wg      sync.WaitGroup
+
+		mu   sync.Mutex
+		size int
+	)
+	serve := func(i int) {
+		res := &result{header: make(http.Header)}
+		h.next.ServeHTTP(res, newRequest(r, raws[i], reqs[i]))
+		results[i] = res
+
+		mu.Lock()
+		size += len(bytes.TrimSpace(res.body.Bytes()))
+		mu.Unlock()
+	}
+	exceeded := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return h.responseMaxSize > 0 && size > h.responseMaxSize
+	}
+
+	for i := range raws {
+		if exceeded() || r.Context().Err() != nil {
+			break
+		}
+		if !isReadOnly(reqs[i].Method) {
+			wg.Wait()
+			serve(i)
+			continue
+		}
+
+		h.workers <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-h.workers
+				wg.Done()
+			}()
+			serve(i)
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// newRequest returns a copy of the batch request with a single request of the batch as body, which
+// is passed already parsed to the next handlers.
+func newRequest(r *http.Request, raw json.RawMessage, parsed rpctypes.JSONRPCRequest) *http.Request {
+	req := r.Clone(r.Context())
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+	req.ContentLength = int64(len(raw))
+	return rpctypes.WithJSONRPCBody(req, &rpctypes.JSONRPCBody{Raw: raw, Requests: []rpctypes.JSONRPCRequest{parsed}})
+}
+
+// responseTooLarge returns the error response of a request dropped because of the response size limit.
+func responseTooLarge(id json.RawMessage) json.RawMessage {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	resp := struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Error   struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{JSONRPC: "2.0", ID: id}
+	resp.Error.Code = errCodeResponseTooLarge
+	resp.Error.Message = errMsgResponseTooLarge
+
+	bz, _ := json.Marshal(resp)
+	return bz
+}
+
+// isReadOnly returns true if the method doesn't change the state of the node.
+func isReadOnly(method string) bool {
+	if readOnlyMethods[method] {
+		return true
+	}
+	if stateMethods[method] {
+		return false
+	}
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
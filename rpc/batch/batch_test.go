@@ -0,0 +1,212 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Helios-Chain-Labs/ethermint/rpc/ratelimit"
+	"github.com/Helios-Chain-Labs/ethermint/server/config"
+)
+
+// testService tracks the number of calls served at the same time.
+type testService struct {
+	mu          sync.Mutex
+	inflight    int
+	maxInflight int
+	overlapped  bool
+}
+
+func (s *testService) enter() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inflight++
+	s.maxInflight = max(s.maxInflight, s.inflight)
+}
+
+func (s *testService) exit() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inflight--
+}
+
+func (s *testService) GetValue(v int) int {
+	s.enter()
+	defer s.exit()
+	time.Sleep(20 * time.Millisecond)
+	return v
+}
+
+func (s *testService) SendValue(v int) int {
+	s.mu.Lock()
+	s.overlapped = s.overlapped || s.inflight > 0
+	s.mu.Unlock()
+
+	s.enter()
+	defer s.exit()
+	time.Sleep(20 * time.Millisecond)
+	return v
+}
+
+type response struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func setup(t *testing.T, cfg *config.JSONRPCConfig) (*testService, *httptest.Server) {
+	service := &testService{}
+	server := rpc.NewServer()
+	server.SetBatchLimits(cfg.BatchRequestLimit, cfg.BatchResponseMaxSize)
+	require.NoError(t, server.RegisterName("eth", service))
+
+	var next http.Handler = server
+	if ratelimit.Enabled(*cfg) {
+		limiter, err := ratelimit.NewLimiter(*cfg, log.NewNopLogger())
+		require.NoError(t, err)
+		next = ratelimit.NewHeavyHandler(next, limiter)
+	}
+	srv := httptest.NewServer(NewHandler(next, *cfg))
+	t.Cleanup(func() {
+		srv.Close()
+		server.Stop()
+	})
+	return service, srv
+}
+
+func newBatch(methods ...string) string {
+	reqs := make([]string, len(methods))
+	for i, method := range methods {
+		reqs[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"%s","params":[%d]}`, i, method, i)
+	}
+	return "[" + strings.Join(reqs, ",") + "]"
+}
+
+func post(t *testing.T, srv *httptest.Server, body string) []response {
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res []response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	return res
+}
+
+func TestBatchConcurrency(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.BatchConcurrency = 4
+	service, srv := setup(t, cfg)
+
+	methods := make([]string, 12)
+	for i := range methods {
+		methods[i] = "eth_getValue"
+	}
+	methods[6] = "eth_sendValue"
+
+	res := post(t, srv, newBatch(methods...))
+	require.Len(t, res, len(methods))
+	for i, r := range res {
+		require.Equal(t, i, r.ID)
+		require.Equal(t, fmt.Sprint(i), string(r.Result))
+	}
+	require.Equal(t, 4, service.maxInflight)
+	// the state changing requests are served alone
+	require.False(t, service.overlapped)
+}
+
+func TestBatchSharedWorkers(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.BatchConcurrency = 4
+	service, srv := setup(t, cfg)
+
+	// the concurrent batches are served by the same workers
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := post(t, srv, newBatch("eth_getValue", "eth_getValue", "eth_getValue", "eth_getValue"))
+			require.Len(t, res, 4)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 4, service.maxInflight)
+}
+
+func TestBatchHeavyCalls(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.BatchConcurrency = 4
+	cfg.MaxConcurrentHeavyCalls = 2
+	cfg.MethodWeights = []string{"eth_getValue=2"}
+	service, srv := setup(t, cfg)
+
+	// each heavy request of the batch takes a slot, the ones without a slot are rejected
+	res := post(t, srv, newBatch("eth_getValue", "eth_getValue", "eth_getValue", "eth_getValue"))
+	require.Len(t, res, 4)
+	rejected := 0
+	for i, r := range res {
+		require.Equal(t, i, r.ID)
+		if r.Error != nil {
+			require.Equal(t, -32005, r.Error.Code)
+			rejected++
+		}
+	}
+	require.Equal(t, 2, rejected)
+	require.Equal(t, 2, service.maxInflight)
+}
+
+func TestBatchLimits(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.BatchRequestLimit = 3
+	// the responses are 35 bytes, the limit is exceeded by the second one
+	cfg.BatchResponseMaxSize = 60
+	_, srv := setup(t, cfg)
+
+	res := post(t, srv, newBatch("eth_getValue", "eth_getValue", "eth_getValue", "eth_getValue"))
+	require.Len(t, res, 1)
+	require.Equal(t, -32600, res[0].Error.Code)
+	require.Equal(t, "batch too large", res[0].Error.Message)
+
+	res = post(t, srv, newBatch("eth_sendValue", "eth_sendValue", "eth_sendValue"))
+	require.Len(t, res, 3)
+	require.Nil(t, res[0].Error)
+	require.Nil(t, res[1].Error)
+	require.Equal(t, 2, res[2].ID)
+	require.Equal(t, -32003, res[2].Error.Code)
+	require.Equal(t, "response too large", res[2].Error.Message)
+}
+
+func TestIsReadOnly(t *testing.T) {
+	for method, readOnly := range map[string]bool{
+		"eth_getBalance":           true,
+		"eth_call":                 true,
+		"debug_traceTransaction":   true,
+		"trace_block":              true,
+		"eth_getFilterChanges":     false,
+		"eth_sendRawTransaction":   false,
+		"personal_unlockAccount":   false,
+		"debug_setGCPercent":       false,
+		"miner_setGasPrice":        false,
+		"eth_newFilter":            false,
+		"net_version":              true,
+		"debug_getHeaderRlp":       true,
+		"txpool_content":           true,
+		"eth_signTypedData":        false,
+		"eth_maxPriorityFeePerGas": true,
+	} {
+		require.Equal(t, readOnly, isReadOnly(method), method)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package metrics
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+)
+
+// message holds a JSON-RPC message read from a websocket connection until its response is written.
+type message struct {
+	reqs  []rpctypes.JSONRPCRequest
+	batch bool
+	start time.Time
+}
+
+// Conn records the metrics of the JSON-RPC calls of a websocket connection, the latency of a call is
+// measured from the read of its message to the write of its response.
+type Conn struct {
+	mu sync.Mutex
+	// messages being served, by id of their first call
+	pending map[string]message
+}
+
+// NewConn returns the metrics recorder of a websocket connection, it's nil when the metrics are disabled.
+func NewConn() *Conn {
+	if !ethmetrics.Enabled {
+		return nil
+	}
+	return &Conn{pending: make(map[string]message)}
+}
+
+// Read records a message read from the connection, the messages without id have no response so
+// their calls are recorded right away, without latency.
+func (c *Conn) Read(msg []byte) {
+	if c == nil {
+		return
+	}
+	reqs, batch := rpctypes.ParseJSONRPCRequests(msg)
+	for _, req := range reqs {
+		if len(req.ID) > 0 {
+			c.mu.Lock()
+			c.pending[string(req.ID)] = message{reqs: reqs, batch: batch, start: time.Now()}
+			c.mu.Unlock()
+			return
+		}
+	}
+	for _, req := range reqs {
+		ObserveCall(req.Method, -1, 0, req.Size, 0)
+	}
+}
+
+// Written records the calls of the message of a response written to the connection, the responses
+// of a batch are identified by the first one.
+func (c *Conn) Written(resp []byte) {
+	if c == nil {
+		return
+	}
+	reqs, _ := rpctypes.ParseJSONRPCRequests(resp)
+	if len(reqs) == 0 || len(reqs[0].ID) == 0 {
+		return
+	}
+	id := string(reqs[0].ID)
+
+	c.mu.Lock()
+	msg, ok := c.pending[id]
+	delete(c.pending, id)
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+	duration := time.Since(msg.start)
+
+	if !msg.batch {
+		var res response
+		_ = json.Unmarshal(resp, &res)
+		ObserveCall(msg.reqs[0].Method, duration, errorCode(res), msg.reqs[0].Size, len(resp))
+		return
+	}
+
+	observeBatch(msg.reqs, duration, resp)
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+)
+
+const (
+	// unknownMethod groups the calls of the methods which are not served, so arbitrary
+	// method names don't create new metrics.
+	unknownMethod = "unknown"
+
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+)
+
+var batchTimer = ethmetrics.NewRegisteredTimer("ethermint/rpc/batch/duration", nil)
+
+// ObserveCall records the latency, error code and payload sizes of a JSON-RPC call in the metrics of its
+// method and namespace, a negative duration is not recorded. The error code is 0 for a successful call.
+func ObserveCall(method string, duration time.Duration, errCode int, reqSize, respSize int) {
+	if !ethmetrics.Enabled {
+		return
+	}
+	namespace, _, ok := strings.Cut(method, "_")
+	if !ok || errCode == errCodeMethodNotFound || errCode == errCodeInvalidRequest || errCode == errCodeParse {
+		method, namespace = unknownMethod, unknownMethod
+	}
+
+	for _, prefix := range []string{"ethermint/rpc/method/" + method, "ethermint/rpc/namespace/" + namespace} {
+		if duration >= 0 {
+			ethmetrics.GetOrRegisterTimer(prefix+"/duration", nil).Update(duration)
+		}
+		ethmetrics.GetOrRegisterHistogramLazy(prefix+"/request/size", nil, newSample).Update(int64(reqSize))
+		ethmetrics.GetOrRegisterHistogramLazy(prefix+"/response/size", nil, newSample).Update(int64(respSize))
+		if errCode != 0 {
+			ethmetrics.GetOrRegisterCounter(fmt.Sprintf("%s/errors/%d", prefix, errCode), nil).Inc(1)
+		}
+	}
+}
+
+func newSample() ethmetrics.Sample {
+	return ethmetrics.NewExpDecaySample(1028, 0.015)
+}
+
+// response holds the fields of a JSON-RPC response used by the metrics.
+type response struct {
+	ID    json.RawMessage `json:"id"`
+	Error *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// recorder copies the response body written to the underlying writer.
+type recorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// handler is a http middleware recording the metrics of the JSON-RPC calls.
+type handler struct {
+	next http.Handler
+}
+
+// NewHandler creates a http.Handler recording the metrics of the JSON-RPC calls served by the next one.
+// The calls of a batch are recorded without their latency, which is recorded for the whole batch.
+// The next handler is returned as is when the metrics are disabled.
+func NewHandler(next http.Handler) http.Handler {
+	if !ethmetrics.Enabled {
+		return next
+	}
+	return &handler{next: next}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r, body, err := rpctypes.ReadJSONRPCBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rec := &recorder{ResponseWriter: w}
+	start := time.Now()
+	h.next.ServeHTTP(rec, r)
+	duration := time.Since(start)
+
+	reqs := body.Requests
+	if !body.Batch {
+		var resp response
+		_ = json.Unmarshal(rec.body.Bytes(), &resp)
+		ObserveCall(reqs[0].Method, duration, errorCode(resp), reqs[0].Size, rec.body.Len())
+		return
+	}
+
+	observeBatch(reqs, duration, rec.body.Bytes())
+}
+
+// observeBatch records the latency of a batch and its calls, which are matched with their responses by id.
+func observeBatch(reqs []rpctypes.JSONRPCRequest, duration time.Duration, body []byte) {
+	batchTimer.Update(duration)
+	var raws []json.RawMessage
+	_ = json.Unmarshal(body, &raws)
+	responses := make(map[string]json.RawMessage, len(raws))
+	for _, raw := range raws {
+		var resp response
+		if err := json.Unmarshal(raw, &resp); err == nil {
+			responses[string(resp.ID)] = raw
+		}
+	}
+	for _, req := range reqs {
+		raw := responses[string(req.ID)]
+		var resp response
+		_ = json.Unmarshal(raw, &resp)
+		ObserveCall(req.Method, -1, errorCode(resp), req.Size, len(raw))
+	}
+}
+
+func errorCode(resp response) int {
+	if resp.Error == nil {
+		return 0
+	}
+	return resp.Error.Code
+}
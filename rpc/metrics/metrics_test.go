@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func enableMetrics(t *testing.T) {
+	enabled := ethmetrics.Enabled
+	ethmetrics.Enabled = true
+	t.Cleanup(func() {
+		ethmetrics.Enabled = enabled
+	})
+}
+
+func timerCount(name string) int64 {
+	if timer, ok := ethmetrics.DefaultRegistry.Get(name).(ethmetrics.Timer); ok {
+		return timer.Snapshot().Count()
+	}
+	return 0
+}
+
+func counterCount(name string) int64 {
+	if counter, ok := ethmetrics.DefaultRegistry.Get(name).(ethmetrics.Counter); ok {
+		return counter.Snapshot().Count()
+	}
+	return 0
+}
+
+func TestObserveCall(t *testing.T) {
+	enableMetrics(t)
+
+	ObserveCall("eth_chainId", 0, 0, 10, 20)
+	ObserveCall("eth_chainId", 0, -32000, 10, 20)
+	ObserveCall("eth_call", -1, 0, 10, 20)
+	require.Equal(t, int64(2), timerCount("ethermint/rpc/method/eth_chainId/duration"))
+	require.Equal(t, int64(1), counterCount("ethermint/rpc/method/eth_chainId/errors/-32000"))
+	require.Equal(t, int64(2), timerCount("ethermint/rpc/namespace/eth/duration"))
+	require.Equal(t, int64(0), timerCount("ethermint/rpc/method/eth_call/duration"))
+	hist, ok := ethmetrics.DefaultRegistry.Get("ethermint/rpc/method/eth_call/request/size").(ethmetrics.Histogram)
+	require.True(t, ok)
+	require.Equal(t, int64(10), hist.Snapshot().Max())
+
+	// the methods which are not served are grouped
+	ObserveCall("eth_foo", 0, errCodeMethodNotFound, 10, 20)
+	ObserveCall("foo", 0, 0, 10, 20)
+	require.Nil(t, ethmetrics.DefaultRegistry.Get("ethermint/rpc/method/eth_foo/duration"))
+	require.Nil(t, ethmetrics.DefaultRegistry.Get("ethermint/rpc/method/foo/duration"))
+	require.Equal(t, int64(2), timerCount("ethermint/rpc/method/unknown/duration"))
+}
+
+func TestHandler(t *testing.T) {
+	enableMetrics(t)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if strings.HasPrefix(string(body), "[") {
+			_, _ = w.Write([]byte(`[{"jsonrpc":"2.0","id":2,"error":{"code":-32000,"message":"failed"}},{"jsonrpc":"2.0","id":1,"result":"0x1"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	})
+	h := NewHandler(next)
+
+	serve := func(body string) string {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		return w.Body.String()
+	}
+
+	// the request is passed unchanged to the next handler
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, serve(`{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion"}`))
+	require.Equal(t, int64(1), timerCount("ethermint/rpc/method/web3_clientVersion/duration"))
+
+	// the calls of a batch are matched with their responses by id
+	serve(`[{"jsonrpc":"2.0","id":1,"method":"net_version"},{"jsonrpc":"2.0","id":2,"method":"net_listening"}]`)
+	require.Equal(t, int64(0), timerCount("ethermint/rpc/method/net_version/duration"))
+	require.Equal(t, int64(0), counterCount("ethermint/rpc/method/net_version/errors/-32000"))
+	require.Equal(t, int64(1), counterCount("ethermint/rpc/method/net_listening/errors/-32000"))
+	hist, ok := ethmetrics.DefaultRegistry.Get("ethermint/rpc/namespace/net/request/size").(ethmetrics.Histogram)
+	require.True(t, ok)
+	require.Equal(t, int64(2), hist.Snapshot().Count())
+}
+
+func TestConn(t *testing.T) {
+	enableMetrics(t)
+
+	c := NewConn()
+	// the calls are recorded once their response is written
+	c.Read([]byte(`{"jsonrpc":"2.0","id":1,"method":"eth_protocolVersion"}`))
+	require.Equal(t, int64(0), timerCount("ethermint/rpc/method/eth_protocolVersion/duration"))
+	c.Written([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x41"}`))
+	require.Equal(t, int64(1), timerCount("ethermint/rpc/method/eth_protocolVersion/duration"))
+
+	// the subscription notifications are not matched with a call
+	c.Written([]byte(`{"jsonrpc":"2.0","method":"eth_subscription","params":{}}`))
+	require.Nil(t, ethmetrics.DefaultRegistry.Get("ethermint/rpc/method/eth_subscription/duration"))
+
+	// the calls of a batch are matched with their responses by id
+	c.Read([]byte(`[{"jsonrpc":"2.0","id":"a","method":"eth_syncing"},{"jsonrpc":"2.0","id":"b","method":"eth_mining"}]`))
+	c.Written([]byte(`[{"jsonrpc":"2.0","id":"a","result":false},{"jsonrpc":"2.0","id":"b","error":{"code":-32000,"message":"failed"}}]`))
+	require.Equal(t, int64(0), counterCount("ethermint/rpc/method/eth_syncing/errors/-32000"))
+	require.Equal(t, int64(1), counterCount("ethermint/rpc/method/eth_mining/errors/-32000"))
+	require.Empty(t, c.pending)
+
+	// the recorder is nil and ignores the messages when the metrics are disabled
+	ethmetrics.Enabled = false
+	require.Nil(t, NewConn())
+	NewConn().Read([]byte(`{"jsonrpc":"2.0","id":1,"method":"eth_protocolVersion"}`))
+}
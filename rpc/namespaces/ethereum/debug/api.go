@@ -330,6 +330,39 @@ func (a *API) GetBlockRlp(number uint64) (hexutil.Bytes, error) {
 	return rlp.EncodeToBytes(block)
 }
 
+// GetRawBlock retrieves the RLP encoded for of a single block identified by number or hash.
+func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := a.backend.EthBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(block)
+}
+
+// GetRawReceipts retrieves the binary encoded receipts of a single block.
+func (a *API) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+	return a.backend.GetRawReceipts(blockNrOrHash)
+}
+
+// GetRawTransaction returns the bytes of the transaction identified by hash.
+func (a *API) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawTransaction", "hash", hash.Hex())
+	return a.backend.GetRawTransactionByHash(hash)
+}
+
+// IndexerStatus returns the block range covered by the custom tx indexer and the block gaps it has skipped
+// because the blocks were not available on the node.
+func (a *API) IndexerStatus() (*rpctypes.IndexerStatus, error) {
+	a.logger.Debug("debug_indexerStatus")
+	return a.backend.IndexerStatus()
+}
+
 // PrintBlock retrieves a block and returns its pretty printed form.
 func (a *API) PrintBlock(number uint64) (string, error) {
 	block, err := a.backend.EthBlockByNumber(rpctypes.BlockNumber(number))
@@ -341,8 +374,45 @@ func (a *API) PrintBlock(number uint64) (string, error) {
 }
 
 // IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
+// of intermediate roots: the state fingerprint after each transaction.
 func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+
+	return a.backend.IntermediateRoots(resBlock)
+}
+
+// StorageRangeAt returns the storage of the contract at the beginning of the given block, only
+// txIndex 0 is supported and the slots are paged by the raw slot.
+func (a *API) StorageRangeAt(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (*rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "block", blockNrOrHash, "txIndex", txIndex, "address", contractAddress.Hex())
+	return a.backend.StorageRangeAt(blockNrOrHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange enumerates the accounts at the given block ordered by address, starting from the
+// start address. The incompletes flag is ignored since the addresses are always known. The storage
+// of each account is capped, the rest of it is paged with StorageRangeAt from its next storage key.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (*rpctypes.AccountRangeResult, error) {
+	a.logger.Debug("debug_accountRange", "block", blockNrOrHash, "start", start, "maxResults", maxResults)
+	return a.backend.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
 }
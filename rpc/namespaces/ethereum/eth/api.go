@@ -62,7 +62,9 @@ type EthereumAPI interface {
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
-	// eth_getBlockReceipts
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	GetRawTransactionByHash(hash common.Hash) (hexutil.Bytes, error)
+	GetRawTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (hexutil.Bytes, error)
 
 	// Writing Transactions
 	//
@@ -87,6 +89,8 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) (json.RawMessage, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error)
 
 	// Chain Information
 	//
@@ -198,6 +202,12 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 	return e.backend.GetTransactionReceipt(hash)
 }
 
+// GetBlockReceipts returns the receipts of all the transactions in the block identified by number or hash.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlockReceipts(blockNrOrHash)
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *PublicAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
@@ -222,6 +232,18 @@ func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockN
 	return e.backend.GetTransactionByBlockNumberAndIndex(blockNum, idx)
 }
 
+// GetRawTransactionByHash returns the bytes of the transaction identified by hash.
+func (e *PublicAPI) GetRawTransactionByHash(hash common.Hash) (hexutil.Bytes, error) {
+	e.logger.Debug("eth_getRawTransactionByHash", "hash", hash.Hex())
+	return e.backend.GetRawTransactionByHash(hash)
+}
+
+// GetRawTransactionByBlockNumberAndIndex returns the bytes of the transaction identified by number and index.
+func (e *PublicAPI) GetRawTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (hexutil.Bytes, error) {
+	e.logger.Debug("eth_getRawTransactionByBlockNumberAndIndex", "number", blockNum, "index", idx)
+	return e.backend.GetRawTransactionByBlockNumberAndIndex(blockNum, idx)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Write Txs					                            ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -298,6 +320,39 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes the calls of a sequence of simulated blocks on top of the given block, with the
+// block and state overrides of each block.
+func (e *PublicAPI) SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) (json.RawMessage, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
+// CreateAccessList creates an EIP-2930 access list for the transaction on top of the given block, the
+// precompiled contracts, the sender and the recipient are not included.
+func (e *PublicAPI) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.CreateAccessList(args, blockNum)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -481,16 +536,16 @@ func (e *PublicAPI) FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.Si
 	}
 
 	// Assemble the transaction and obtain rlp
-	tx := args.ToTransaction().AsTransaction()
+	msg := args.ToTransaction()
 
-	data, err := tx.MarshalBinary()
+	data, err := msg.MarshalBinary()
 	if err != nil {
 		return nil, err
 	}
 
 	return &rpctypes.SignTransactionResult{
 		Raw: data,
-		Tx:  tx,
+		Tx:  msg.AsTransaction(),
 	}, nil
 }
 
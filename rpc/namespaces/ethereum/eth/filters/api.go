@@ -57,6 +57,7 @@ type Backend interface {
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
+	FilterLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
 
 	BloomStatus() (uint64, uint64)
 
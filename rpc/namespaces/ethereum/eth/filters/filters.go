@@ -167,7 +167,13 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	to := f.criteria.ToBlock.Int64()
 	logs := []*ethtypes.Log{}
 
-	for height := from; height <= to; height++ {
+	// skip the blocks which can't contain any matching log according to the indexer
+	heights, err := f.backend.FilterLogHeights(from, to, f.criteria.Addresses, f.criteria.Topics)
+	if err != nil {
+		return logs, errors.Wrap(err, "failed to filter log heights")
+	}
+
+	for _, height := range heights {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
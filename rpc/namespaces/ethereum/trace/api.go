@@ -0,0 +1,337 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package trace
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Helios-Chain-Labs/ethermint/rpc/backend"
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// traceTypeTrace is the only trace type supported by trace_call and
+// trace_replayBlockTransactions.
+const traceTypeTrace = "trace"
+
+// FilterArgs defines the parameters of a trace_filter request.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// API is the collection of OpenEthereum compatible tracing APIs, built on top of the
+// callTracer.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the trace methods.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat traces of all the transactions of a block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	resBlock, err := a.getBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := a.traceBlock(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*rpctypes.ParityTrace{}
+	for _, res := range results {
+		traces = append(traces, res.Trace...)
+	}
+	return traces, nil
+}
+
+// Transaction returns the flat traces of a transaction.
+func (a *API) Transaction(hash common.Hash) ([]*rpctypes.ParityTrace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	res, err := a.backend.GetTxByEthHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := a.backend.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for height %d", res.Height)
+	}
+
+	result, err := a.backend.TraceTransaction(hash, callTracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	frame, err := rpctypes.DecodeCallFrame(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpctypes.FlattenCallFrame(frame, &rpctypes.ParityTraceContext{
+		BlockHash:           common.BytesToHash(resBlock.BlockID.Hash),
+		BlockNumber:         uint64(res.Height),
+		TransactionHash:     hash,
+		TransactionPosition: uint64(res.EthTxIndex),
+	}), nil
+}
+
+// Filter returns the flat traces in a block range matching the given from and to
+// addresses.
+func (a *API) Filter(args FilterArgs) ([]*rpctypes.ParityTrace, error) {
+	a.logger.Debug("trace_filter", "args", args)
+	from, err := a.resolveBlockNumber(args.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := a.resolveBlockNumber(args.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+	// genesis is not traceable
+	from = max(from, 1)
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d is greater than to %d", from, to)
+	}
+	if blockRangeCap := int64(a.backend.RPCBlockRangeCap()); to-from > blockRangeCap {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRangeCap)
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	traces := []*rpctypes.ParityTrace{}
+	if args.Count != nil {
+		count = *args.Count
+		if count == 0 {
+			return traces, nil
+		}
+	}
+
+	full := func() bool {
+		return args.Count != nil && uint64(len(traces)) >= count
+	}
+	for height := from; height <= to && !full(); height++ {
+		resBlock, err := a.getBlock(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+
+		results, err := a.traceBlock(resBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range results {
+			for _, trace := range res.Trace {
+				if !trace.Matches(args.FromAddress, args.ToAddress) {
+					continue
+				}
+				if after > 0 {
+					after--
+					continue
+				}
+				if full() {
+					return traces, nil
+				}
+				traces = append(traces, trace)
+			}
+		}
+	}
+	return traces, nil
+}
+
+// Call executes a call on top of the given block and returns its flat traces.
+// Only the "trace" trace type is supported.
+func (a *API) Call(
+	args evmtypes.TransactionArgs,
+	traceTypes []string,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.ParityTraceResults, error) {
+	a.logger.Debug("trace_call", "args", args.String(), "block number or hash", blockNrOrHash)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		blockNrOrHash = &rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+
+	result, err := a.backend.TraceCall(args, *blockNrOrHash, callTracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	frame, err := rpctypes.DecodeCallFrame(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.ParityTraceResults{
+		Output: frame.Output,
+		Trace:  rpctypes.FlattenCallFrame(frame, nil),
+	}, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of a block and returns the
+// flat traces of each of them. Only the "trace" trace type is supported.
+func (a *API) ReplayBlockTransactions(
+	blockNr rpctypes.BlockNumber,
+	traceTypes []string,
+) ([]*rpctypes.ParityTraceResults, error) {
+	a.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+
+	resBlock, err := a.getBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := a.traceBlock(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// the trace fields are only included in trace_block and trace_transaction
+	for _, res := range results {
+		for _, trace := range res.Trace {
+			trace.BlockHash = nil
+			trace.BlockNumber = nil
+			trace.TransactionHash = nil
+			trace.TransactionPosition = nil
+		}
+	}
+	return results, nil
+}
+
+// getBlock returns the Tendermint block at the given height, which must not be the
+// genesis block.
+func (a *API) getBlock(blockNr rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", blockNr, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", blockNr)
+	}
+	return resBlock, nil
+}
+
+// traceBlock traces all the ethereum transactions of a block with the callTracer
+// and returns their flat traces in block order.
+func (a *API) traceBlock(resBlock *tmrpctypes.ResultBlock) ([]*rpctypes.ParityTraceResults, error) {
+	msgs := a.backend.EthMsgsFromTendermintBlock(resBlock, nil)
+	if len(msgs) == 0 {
+		return []*rpctypes.ParityTraceResults{}, nil
+	}
+
+	height := resBlock.Block.Height
+	txResults, err := a.backend.TraceBlock(rpctypes.BlockNumber(height), callTracerConfig(), resBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(txResults) != len(msgs) {
+		return nil, fmt.Errorf("trace results mismatch in block %d: expected %d, got %d", height, len(msgs), len(txResults))
+	}
+
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	results := make([]*rpctypes.ParityTraceResults, len(msgs))
+	for i, txResult := range txResults {
+		txHash := msgs[i].Hash()
+		if txResult.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", txHash.Hex(), txResult.Error)
+		}
+
+		frame, err := rpctypes.DecodeCallFrame(txResult.Result)
+		if err != nil {
+			return nil, err
+		}
+
+		results[i] = &rpctypes.ParityTraceResults{
+			Output: frame.Output,
+			Trace: rpctypes.FlattenCallFrame(frame, &rpctypes.ParityTraceContext{
+				BlockHash:           blockHash,
+				BlockNumber:         uint64(height),
+				TransactionHash:     txHash,
+				TransactionPosition: uint64(i),
+			}),
+			TransactionHash: &txHash,
+		}
+	}
+	return results, nil
+}
+
+// resolveBlockNumber returns the height of the given block number, defaulting to the
+// latest block.
+func (a *API) resolveBlockNumber(blockNr *rpctypes.BlockNumber) (int64, error) {
+	if blockNr != nil && *blockNr >= 0 {
+		return blockNr.Int64(), nil
+	}
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return 0, err
+	}
+	return int64(latest), nil
+}
+
+func validateTraceTypes(traceTypes []string) error {
+	for _, traceType := range traceTypes {
+		if traceType != traceTypeTrace {
+			return fmt.Errorf("trace type %s is not supported", traceType)
+		}
+	}
+	return nil
+}
+
+func callTracerConfig() *rpctypes.TraceConfig {
+	return &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: rpctypes.CallTracerName},
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+)
+
+// Conn applies the limits of the limiter to the JSON-RPC messages of a websocket connection, each
+// message spends its tokens when it's read. The heavy slot of a message is held until its response is
+// written, so the heavy calls without id are rejected since they have no response.
+type Conn struct {
+	limiter *Limiter
+	key     string
+
+	mu sync.Mutex
+	// releases of the heavy slots held by the messages being served, by id of their first call
+	pending map[string][]func()
+}
+
+// NewConn returns the limits of the websocket connection upgraded from the request.
+func (l *Limiter) NewConn(r *http.Request) *Conn {
+	return &Conn{
+		limiter: l,
+		key:     l.clientKey(r),
+		pending: make(map[string][]func()),
+	}
+}
+
+// Admit checks the limits of a message read from the connection, it returns the error response to
+// write back if the message is rejected, nil otherwise.
+func (c *Conn) Admit(msg []byte) []byte {
+	reqs, batch := rpctypes.ParseJSONRPCRequests(msg)
+	var id json.RawMessage
+	for _, req := range reqs {
+		if len(req.ID) > 0 {
+			id = req.ID
+			break
+		}
+	}
+	if _, heavy := c.limiter.cost(reqs); heavy && c.limiter.heavy != nil && len(id) == 0 {
+		return errorResponse(reqs, batch, errHeavyWithoutID)
+	}
+
+	release, reason := c.limiter.admit(c.key, reqs)
+	if reason != nil {
+		return errorResponse(reqs, batch, reason)
+	}
+	if release != nil {
+		c.mu.Lock()
+		c.pending[string(id)] = append(c.pending[string(id)], release)
+		c.mu.Unlock()
+	}
+	return nil
+}
+
+// Written releases the heavy slot held by the message of a response written to the connection, the
+// responses of a batch are identified by the first one.
+func (c *Conn) Written(resp []byte) {
+	reqs, _ := rpctypes.ParseJSONRPCRequests(resp)
+	if len(reqs) == 0 || len(reqs[0].ID) == 0 {
+		return
+	}
+	id := string(reqs[0].ID)
+
+	c.mu.Lock()
+	releases := c.pending[id]
+	if len(releases) == 0 {
+		c.mu.Unlock()
+		return
+	}
+	if len(releases) == 1 {
+		delete(c.pending, id)
+	} else {
+		c.pending[id] = releases[1:]
+	}
+	c.mu.Unlock()
+
+	releases[0]()
+}
+
+// Close releases the heavy slots still held once the connection is closed.
+func (c *Conn) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, releases := range c.pending {
+		for _, release := range releases {
+			release()
+		}
+	}
+	c.pending = make(map[string][]func())
+}
@@ -0,0 +1,387 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package ratelimit
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	"github.com/Helios-Chain-Labs/ethermint/server/config"
+)
+
+const (
+	// APIKeyHeader is the header of the API key of the client.
+	APIKeyHeader = "X-API-Key"
+
+	// forwardedForHeader and realIPHeader are the headers of the client IP set by the reverse proxies.
+	forwardedForHeader = "X-Forwarded-For"
+	realIPHeader       = "X-Real-IP"
+
+	// errCodeLimitExceeded is the JSON-RPC error code of the requests rejected by the limits, as defined
+	// in EIP-1474, the client can retry them later.
+	errCodeLimitExceeded = -32005
+	// errCodeInvalidRequest is the JSON-RPC error code of the requests which can never be admitted.
+	errCodeInvalidRequest = -32600
+
+	// clientIdleTimeout is the duration after which the bucket of an inactive client is dropped.
+	clientIdleTimeout = 3 * time.Minute
+)
+
+var (
+	rejectedRateLimitCounter   = metrics.NewRegisteredCounter("rpc/rejected/ratelimit", nil)
+	rejectedConcurrencyCounter = metrics.NewRegisteredCounter("rpc/rejected/concurrency", nil)
+)
+
+// rejection is the reason of the rejection of requests.
+type rejection struct {
+	code    int
+	status  int
+	message string
+}
+
+var (
+	errRateLimited  = &rejection{errCodeLimitExceeded, http.StatusTooManyRequests, "rate limit exceeded"}
+	errHeavyLimited = &rejection{
+		errCodeLimitExceeded, http.StatusTooManyRequests, "too many concurrent heavy requests",
+	}
+	errHeavyWithoutID = &rejection{
+		errCodeLimitExceeded, http.StatusTooManyRequests, "heavy methods must be called with an id",
+	}
+	// errCostExceedsBurst rejects the batches spending more tokens than the bucket can ever hold, they
+	// are not retryable, the client has to split them.
+	errCostExceedsBurst = &rejection{
+		errCodeInvalidRequest, http.StatusBadRequest, "batch cost exceeds the rate limit burst",
+	}
+)
+
+// client is the token bucket of a client IP or API key.
+type client struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter limits the JSON-RPC requests of each client with a token bucket, where each method spends
+// its weight in tokens. It also limits the number of concurrent requests with heavy methods. It's
+// shared by the http and the websocket servers, so a client has the same bucket on both.
+type Limiter struct {
+	logger  log.Logger
+	limit   rate.Limit
+	burst   int
+	apiKeys map[string]bool
+	weights map[string]int
+	// proxies are the networks of the trusted reverse proxies
+	proxies []*net.IPNet
+	// heavy holds a slot for each request with heavy methods being served, nil if unlimited
+	heavy chan struct{}
+
+	mu      sync.Mutex
+	clients map[string]*client
+}
+
+// NewLimiter creates the limiter of the JSON-RPC servers.
+func NewLimiter(cfg config.JSONRPCConfig, logger log.Logger) (*Limiter, error) {
+	weights, err := config.ParseMethodWeights(cfg.MethodWeights)
+	if err != nil {
+		return nil, err
+	}
+	proxies, err := config.ParseTrustedProxies(cfg.RateLimitTrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Limiter{
+		logger:  logger.With("module", "ratelimit"),
+		limit:   rate.Limit(cfg.RateLimitPerSecond),
+		burst:   cfg.RateLimitBurst,
+		apiKeys: make(map[string]bool, len(cfg.RateLimitAPIKeys)),
+		weights: weights,
+		proxies: proxies,
+		clients: make(map[string]*client),
+	}
+	for _, key := range cfg.RateLimitAPIKeys {
+		l.apiKeys[key] = true
+	}
+	if cfg.MaxConcurrentHeavyCalls > 0 {
+		l.heavy = make(chan struct{}, cfg.MaxConcurrentHeavyCalls)
+	}
+	if l.limit > 0 {
+		go l.cleanupLoop()
+	}
+
+	return l, nil
+}
+
+// Enabled returns true if any of the limits is set in the config.
+func Enabled(cfg config.JSONRPCConfig) bool {
+	return cfg.RateLimitPerSecond > 0 || cfg.MaxConcurrentHeavyCalls > 0
+}
+
+// admit checks the limits of the requests of the client. It returns the function releasing the heavy
+// slot taken by the requests, nil if none is taken, or the reason of the rejection.
+func (l *Limiter) admit(key string, reqs []rpctypes.JSONRPCRequest) (release func(), reason *rejection) {
+	release, reason = l.acquireHeavy(reqs)
+	if reason != nil {
+		return nil, reason
+	}
+	if reason := l.allow(key, reqs); reason != nil {
+		if release != nil {
+			release()
+		}
+		return nil, reason
+	}
+	return release, nil
+}
+
+// acquireHeavy takes a heavy slot if any of the requests calls a heavy method. It returns the function
+// releasing the slot, nil if none is taken, or the reason of the rejection.
+func (l *Limiter) acquireHeavy(reqs []rpctypes.JSONRPCRequest) (release func(), reason *rejection) {
+	if _, heavy := l.cost(reqs); !heavy || l.heavy == nil {
+		return nil, nil
+	}
+	select {
+	case l.heavy <- struct{}{}:
+		return func() { <-l.heavy }, nil
+	default:
+		rejectedConcurrencyCounter.Inc(1)
+		return nil, errHeavyLimited
+	}
+}
+
+// allow spends the tokens of the requests from the bucket of the client, it returns the reason of the
+// rejection if there are not enough tokens. A batch costing more than the burst is never allowed, so it's
+// rejected with a distinct reason instead of the retryable one.
+func (l *Limiter) allow(key string, reqs []rpctypes.JSONRPCRequest) *rejection {
+	if l.limit <= 0 {
+		return nil
+	}
+	cost, _ := l.cost(reqs)
+	if cost > l.burst {
+		l.logger.Debug("batch cost exceeds the rate limit burst", "client", key, "cost", cost)
+		rejectedRateLimitCounter.Inc(1)
+		return errCostExceedsBurst
+	}
+	if !l.limiter(key).AllowN(time.Now(), cost) {
+		l.logger.Debug("request rate limited", "client", key, "cost", cost)
+		rejectedRateLimitCounter.Inc(1)
+		return errRateLimited
+	}
+	return nil
+}
+
+// cost returns the tokens spent by the requests, and whether any of them calls a heavy method.
+func (l *Limiter) cost(reqs []rpctypes.JSONRPCRequest) (cost int, heavy bool) {
+	for _, req := range reqs {
+		weight, ok := l.weights[req.Method]
+		if !ok {
+			weight = config.DefaultMethodWeight
+		}
+		cost += weight
+		heavy = heavy || weight > config.DefaultMethodWeight
+	}
+	return max(cost, config.DefaultMethodWeight), heavy
+}
+
+// clientKey returns the API key of the request if it's a known one, otherwise the client IP.
+func (l *Limiter) clientKey(r *http.Request) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" && l.apiKeys[key] {
+		return "key:" + key
+	}
+	return "ip:" + l.clientIP(r)
+}
+
+// clientIP returns the IP of the client of the request. The headers set by the reverse proxies are only read
+// if the request comes from a trusted one. The X-Forwarded-For addresses are walked from the right, the first
+// one which is not a trusted proxy is the client, the addresses on its left can be forged by the client.
+func (l *Limiter) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !l.trusted(host) {
+		return host
+	}
+
+	if values := r.Header.Values(forwardedForHeader); len(values) > 0 {
+		addrs := strings.Split(strings.Join(values, ","), ",")
+		for i := len(addrs) - 1; i >= 0; i-- {
+			addr := strings.TrimSpace(addrs[i])
+			if net.ParseIP(addr) == nil {
+				break
+			}
+			host = addr
+			if !l.trusted(addr) {
+				break
+			}
+		}
+		return host
+	}
+	if addr := strings.TrimSpace(r.Header.Get(realIPHeader)); net.ParseIP(addr) != nil {
+		return addr
+	}
+	return host
+}
+
+// trusted returns true if the address is one of a trusted proxy.
+func (l *Limiter) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range l.proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// limiter returns the token bucket of the client, creating it if needed.
+func (l *Limiter) limiter(key string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c, ok := l.clients[key]
+	if !ok {
+		c = &client{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[key] = c
+	}
+	c.lastSeen = time.Now()
+	return c.limiter
+}
+
+// cleanupLoop drops the buckets of the inactive clients periodically.
+func (l *Limiter) cleanupLoop() {
+	ticker := time.NewTicker(clientIdleTimeout)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		l.mu.Lock()
+		for key, c := range l.clients {
+			if time.Since(c.lastSeen) > clientIdleTimeout {
+				delete(l.clients, key)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+// Handler is a http middleware of the JSON-RPC server which rate limits the requests with the token
+// bucket of the client, a batch spends the tokens of all its requests.
+type Handler struct {
+	next    http.Handler
+	limiter *Limiter
+}
+
+// NewHandler creates a rate limiting handler in front of the given JSON-RPC handler.
+func NewHandler(next http.Handler, limiter *Limiter) *Handler {
+	return &Handler{next: next, limiter: limiter}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r, reqs, batch, ok := readRequests(w, r)
+	if !ok {
+		return
+	}
+	if reason := h.limiter.allow(h.limiter.clientKey(r), reqs); reason != nil {
+		writeError(w, reqs, batch, reason)
+		return
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+// HeavyHandler is a http middleware of the JSON-RPC server which limits the number of concurrent
+// requests with heavy methods. It's placed behind the batch handler, so each request of a batch
+// served concurrently takes its own slot, a batch served sequentially takes a single one.
+type HeavyHandler struct {
+	next    http.Handler
+	limiter *Limiter
+}
+
+// NewHeavyHandler creates a heavy calls limiting handler in front of the given JSON-RPC handler.
+func NewHeavyHandler(next http.Handler, limiter *Limiter) *HeavyHandler {
+	return &HeavyHandler{next: next, limiter: limiter}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *HeavyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r, reqs, batch, ok := readRequests(w, r)
+	if !ok {
+		return
+	}
+	release, reason := h.limiter.acquireHeavy(reqs)
+	if reason != nil {
+		writeError(w, reqs, batch, reason)
+		return
+	}
+	if release != nil {
+		defer release()
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+// readRequests returns the JSON-RPC requests of the http request, which are parsed once by the outermost
+// middleware, and the request to pass to the next handler. The error is written if the body can't be read.
+func readRequests(w http.ResponseWriter, r *http.Request) (*http.Request, []rpctypes.JSONRPCRequest, bool, bool) {
+	r, body, err := rpctypes.ReadJSONRPCBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return r, nil, false, false
+	}
+	if body.TooLarge {
+		http.Error(w, "content length too large", http.StatusRequestEntityTooLarge)
+		return r, nil, false, false
+	}
+	return r, body.Requests, body.Batch, true
+}
+
+// writeError writes the JSON-RPC error response of rejected requests.
+func writeError(w http.ResponseWriter, reqs []rpctypes.JSONRPCRequest, batch bool, reason *rejection) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(reason.status)
+	_, _ = w.Write(errorResponse(reqs, batch, reason))
+}
+
+// errorResponse returns the JSON-RPC error response of rejected requests, with the id of the request
+// unless it's a batch.
+func errorResponse(reqs []rpctypes.JSONRPCRequest, batch bool, reason *rejection) []byte {
+	id := json.RawMessage("null")
+	if !batch && len(reqs) == 1 && len(reqs[0].ID) > 0 {
+		id = reqs[0].ID
+	}
+	resp := struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Error   struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{JSONRPC: "2.0", ID: id}
+	resp.Error.Code = reason.code
+	resp.Error.Message = reason.message
+
+	bz, _ := json.Marshal(resp)
+	return bz
+}
@@ -0,0 +1,198 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Helios-Chain-Labs/ethermint/server/config"
+)
+
+func newRequest(body, remoteAddr, apiKey string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.RemoteAddr = remoteAddr
+	if apiKey != "" {
+		r.Header.Set(APIKeyHeader, apiKey)
+	}
+	return r
+}
+
+func newHandler(t *testing.T, next http.HandlerFunc, cfg *config.JSONRPCConfig) *Handler {
+	limiter, err := NewLimiter(*cfg, log.NewNopLogger())
+	require.NoError(t, err)
+	return NewHandler(next, limiter)
+}
+
+func serve(h http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestRateLimit(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimitPerSecond = 0.001
+	cfg.RateLimitBurst = 10
+	cfg.RateLimitAPIKeys = []string{"key"}
+	cfg.MethodWeights = []string{"eth_getLogs=4"}
+
+	var served int
+	h := newHandler(t, func(_ http.ResponseWriter, _ *http.Request) {
+		served++
+	}, cfg)
+
+	getLogs := `{"jsonrpc":"2.0","id":7,"method":"eth_getLogs","params":[{}]}`
+	blockNumber := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+
+	// 4 + 4 + 1 + 1 tokens
+	for _, body := range []string{getLogs, getLogs, blockNumber, blockNumber} {
+		require.Equal(t, http.StatusOK, serve(h, newRequest(body, "1.1.1.1:1000", "")).Code)
+	}
+	w := serve(h, newRequest(getLogs, "1.1.1.1:2000", ""))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":7,"error":{"code":-32005,"message":"rate limit exceeded"}}`, w.Body.String())
+
+	// the batches spend the tokens of all the requests
+	batch := "[" + strings.Repeat(blockNumber+",", 2) + getLogs + "]"
+	require.Equal(t, http.StatusOK, serve(h, newRequest(batch, "2.2.2.2:1000", "")).Code)
+	w = serve(h, newRequest(batch, "2.2.2.2:1000", ""))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32005,"message":"rate limit exceeded"}}`, w.Body.String())
+
+	// the batches costing more than the burst are never allowed, they are rejected as invalid even with a
+	// full bucket
+	batch = "[" + strings.Repeat(blockNumber+",", 10) + blockNumber + "]"
+	w = serve(h, newRequest(batch, "3.3.3.3:1000", ""))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t,
+		`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch cost exceeds the rate limit burst"}}`,
+		w.Body.String(),
+	)
+	require.Equal(t, http.StatusOK, serve(h, newRequest(blockNumber, "3.3.3.3:1000", "")).Code)
+
+	// the known API keys have their own bucket, the unknown ones are limited by IP
+	require.Equal(t, http.StatusOK, serve(h, newRequest(getLogs, "1.1.1.1:1000", "key")).Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(h, newRequest(getLogs, "1.1.1.1:1000", "other")).Code)
+	require.Equal(t, 7, served)
+}
+
+func TestClientIP(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimitTrustedProxies = []string{"10.0.0.1", "192.168.0.0/16"}
+	limiter, err := NewLimiter(*cfg, log.NewNopLogger())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		realIP       string
+		expIP        string
+	}{
+		{"direct client", "1.1.1.1:1000", nil, "", "1.1.1.1"},
+		{"untrusted proxy", "2.2.2.2:1000", []string{"1.1.1.1"}, "1.1.1.1", "2.2.2.2"},
+		{"trusted proxy", "10.0.0.1:1000", []string{"1.1.1.1"}, "", "1.1.1.1"},
+		{"trusted proxy network", "192.168.1.1:1000", []string{"1.1.1.1"}, "", "1.1.1.1"},
+		{"forged address", "10.0.0.1:1000", []string{"3.3.3.3, 1.1.1.1"}, "", "1.1.1.1"},
+		{"chain of trusted proxies", "10.0.0.1:1000", []string{"3.3.3.3, 1.1.1.1", "192.168.1.1"}, "", "1.1.1.1"},
+		{"only trusted proxies", "10.0.0.1:1000", []string{"192.168.1.1"}, "", "192.168.1.1"},
+		{"malformed address", "10.0.0.1:1000", []string{"1.1.1.1, invalid"}, "", "10.0.0.1"},
+		{"real ip", "10.0.0.1:1000", nil, "1.1.1.1", "1.1.1.1"},
+		{"no header", "10.0.0.1:1000", nil, "", "10.0.0.1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRequest("", tc.remoteAddr, "")
+			for _, v := range tc.forwardedFor {
+				r.Header.Add(forwardedForHeader, v)
+			}
+			if tc.realIP != "" {
+				r.Header.Set(realIPHeader, tc.realIP)
+			}
+			require.Equal(t, "ip:"+tc.expIP, limiter.clientKey(r))
+		})
+	}
+}
+
+func TestMaxConcurrentHeavyCalls(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.MaxConcurrentHeavyCalls = 1
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	limiter, err := NewLimiter(*cfg, log.NewNopLogger())
+	require.NoError(t, err)
+	h := NewHeavyHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("block") != "" {
+			close(started)
+			<-release
+		}
+	}), limiter)
+
+	trace := `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":["0x00"]}`
+	blocking := newRequest(trace, "1.1.1.1:1000", "")
+	blocking.Header.Set("block", "true")
+	done := make(chan int)
+	go func() {
+		done <- serve(h, blocking).Code
+	}()
+	<-started
+
+	w := serve(h, newRequest(trace, "2.2.2.2:1000", ""))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Contains(t, w.Body.String(), "too many concurrent heavy requests")
+
+	// the light requests are not limited
+	require.Equal(t, http.StatusOK, serve(h, newRequest(`{"id":1,"method":"eth_blockNumber"}`, "2.2.2.2:1000", "")).Code)
+
+	close(release)
+	select {
+	case code := <-done:
+		require.Equal(t, http.StatusOK, code)
+	case <-time.After(time.Second):
+		t.Fatal("blocked request not served")
+	}
+	require.Equal(t, http.StatusOK, serve(h, newRequest(trace, "2.2.2.2:1000", "")).Code)
+}
+
+func TestConn(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimitPerSecond = 0.001
+	cfg.RateLimitBurst = 10
+	cfg.MaxConcurrentHeavyCalls = 1
+	cfg.MethodWeights = []string{"debug_traceTransaction=4"}
+	limiter, err := NewLimiter(*cfg, log.NewNopLogger())
+	require.NoError(t, err)
+
+	conn := limiter.NewConn(newRequest("", "1.1.1.1:1000", ""))
+	other := limiter.NewConn(newRequest("", "2.2.2.2:1000", ""))
+	trace := []byte(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":["0x00"]}`)
+
+	// the heavy slot is held until the response of the call is written
+	require.Nil(t, conn.Admit(trace))
+	require.JSONEq(t,
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"too many concurrent heavy requests"}}`,
+		string(other.Admit(trace)),
+	)
+	conn.Written([]byte(`{"jsonrpc":"2.0","id":2,"result":"0x1"}`))
+	require.NotNil(t, other.Admit(trace))
+	conn.Written([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+	require.Nil(t, other.Admit(trace))
+
+	// the slots are released once the connection is closed
+	other.Close()
+	require.Nil(t, conn.Admit([]byte(`[{"id":3,"method":"eth_blockNumber"},{"id":4,"method":"debug_traceTransaction"}]`)))
+	conn.Written([]byte(`[{"jsonrpc":"2.0","id":3,"result":"0x1"},{"jsonrpc":"2.0","id":4,"result":{}}]`))
+
+	// the heavy calls without id have no response to release their slot
+	resp := conn.Admit([]byte(`{"jsonrpc":"2.0","method":"debug_traceTransaction","params":["0x00"]}`))
+	require.Contains(t, string(resp), "heavy methods must be called with an id")
+
+	// the client has spent 4 + 5 of its 10 tokens
+	require.Contains(t, string(conn.Admit(trace)), "rate limit exceeded")
+}
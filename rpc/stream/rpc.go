@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"cosmossdk.io/log"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
@@ -13,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/types"
 	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 )
@@ -27,6 +30,9 @@ const (
 	txStreamCapacity        = 1024 * 32
 	logStreamSegmentSize    = 2048
 	logStreamCapacity       = 2048 * 32
+
+	// lagUpdateInterval is the interval to update the lag gauges with the latest committed height
+	lagUpdateInterval = 5 * time.Second
 )
 
 var (
@@ -37,6 +43,14 @@ var (
 		sdk.AttributeKeyModule, evmtypes.ModuleName)).String()
 	blockEvents  = tmtypes.QueryForEvent(tmtypes.EventNewBlock).String()
 	evmTxHashKey = fmt.Sprintf("%s.%s", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash)
+
+	headerSubscribersGauge    = metrics.NewRegisteredGauge("ethermint/stream/header/subscribers", nil)
+	pendingTxSubscribersGauge = metrics.NewRegisteredGauge("ethermint/stream/pendingtx/subscribers", nil)
+	logSubscribersGauge       = metrics.NewRegisteredGauge("ethermint/stream/log/subscribers", nil)
+	// the lag gauges track the number of blocks between the latest committed height and the last height added to
+	// the streams
+	headerLagGauge = metrics.NewRegisteredGauge("ethermint/stream/header/lag", nil)
+	logLagGauge    = metrics.NewRegisteredGauge("ethermint/stream/log/lag", nil)
 )
 
 type RPCHeader struct {
@@ -54,7 +68,12 @@ type RPCStream struct {
 	pendingTxStream *Stream[common.Hash]
 	logStream       *Stream[*ethtypes.Log]
 
-	wg sync.WaitGroup
+	// the heights of the last header and logs added to the streams
+	headerHeight atomic.Int64
+	logHeight    atomic.Int64
+
+	quit chan struct{}
+	wg   sync.WaitGroup
 }
 
 func NewRPCStreams(
@@ -70,7 +89,11 @@ func NewRPCStreams(
 		headerStream:    NewStream[RPCHeader](headerStreamSegmentSize, headerStreamCapacity),
 		pendingTxStream: NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
 		logStream:       NewStream[*ethtypes.Log](logStreamSegmentSize, logStreamCapacity),
+		quit:            make(chan struct{}),
 	}
+	s.headerStream.SetSubscribersGauge(headerSubscribersGauge)
+	s.pendingTxStream.SetSubscribersGauge(pendingTxSubscribersGauge)
+	s.logStream.SetSubscribersGauge(logSubscribersGauge)
 
 	ctx := context.Background()
 
@@ -87,6 +110,15 @@ func NewRPCStreams(
 		return nil, err
 	}
 
+	if statusClient, ok := s.evtClient.(rpcclient.StatusClient); ok && metrics.Enabled {
+		// the streams start from the latest block, the lag is measured from there
+		if status, err := statusClient.Status(ctx); err == nil {
+			s.headerHeight.Store(status.SyncInfo.LatestBlockHeight)
+			s.logHeight.Store(status.SyncInfo.LatestBlockHeight)
+		}
+		go s.trackLag(&s.wg, statusClient)
+	}
+
 	go s.start(&s.wg, chBlocks, chLogs)
 
 	return s, nil
@@ -96,6 +128,7 @@ func (s *RPCStream) Close() error {
 	if err := s.evtClient.UnsubscribeAll(context.Background(), streamSubscriberName); err != nil {
 		return err
 	}
+	close(s.quit)
 	s.wg.Wait()
 	return nil
 }
@@ -149,6 +182,12 @@ func (s *RPCStream) start(
 			// TODO: fetch bloom from events
 			header := types.EthHeaderFromTendermint(data.Block.Header, ethtypes.Bloom{}, baseFee)
 			s.headerStream.Add(RPCHeader{EthHeader: header, Hash: common.BytesToHash(data.Block.Header.Hash())})
+			s.headerHeight.Store(data.Block.Height)
+			// the tx events of the previous blocks are published before this block, so all their logs are added
+			// once the received ones are drained
+			if len(chLogs) == 0 {
+				s.advanceLogHeight(data.Block.Height - 1)
+			}
 
 		case ev, ok := <-chLogs:
 			if !ok {
@@ -174,6 +213,7 @@ func (s *RPCStream) start(
 			}
 
 			s.logStream.Add(txLogs...)
+			s.advanceLogHeight(dataTx.TxResult.Height)
 		}
 
 		if chBlocks == nil && chLogs == nil {
@@ -181,3 +221,43 @@ func (s *RPCStream) start(
 		}
 	}
 }
+
+// advanceLogHeight records that the logs up to the height are added to the log stream.
+func (s *RPCStream) advanceLogHeight(height int64) {
+	if height > s.logHeight.Load() {
+		s.logHeight.Store(height)
+	}
+}
+
+// lags returns the number of blocks between the latest committed height and the last header and logs added to
+// the streams.
+func (s *RPCStream) lags(latest int64) (header, log int64) {
+	return max(latest-s.headerHeight.Load(), 0), max(latest-s.logHeight.Load(), 0)
+}
+
+// trackLag updates the lag gauges with the latest committed height periodically until the streams are closed.
+func (s *RPCStream) trackLag(wg *sync.WaitGroup, statusClient rpcclient.StatusClient) {
+	wg.Add(1)
+	defer wg.Done()
+
+	ticker := time.NewTicker(lagUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), lagUpdateInterval)
+			status, err := statusClient.Status(ctx)
+			cancel()
+			if err != nil {
+				s.logger.Debug("failed to get node status", "error", err.Error())
+				continue
+			}
+			headerLag, logLag := s.lags(status.SyncInfo.LatestBlockHeight)
+			headerLagGauge.Update(headerLag)
+			logLagGauge.Update(logLag)
+		}
+	}
+}
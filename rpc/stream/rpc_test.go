@@ -0,0 +1,24 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCStreamLags(t *testing.T) {
+	s := &RPCStream{}
+	s.headerHeight.Store(10)
+	s.advanceLogHeight(8)
+	// the log height never goes back
+	s.advanceLogHeight(7)
+
+	header, log := s.lags(12)
+	require.Equal(t, int64(2), header)
+	require.Equal(t, int64(4), log)
+
+	// the latest height can be behind the streams when the status is stale
+	header, log = s.lags(5)
+	require.Equal(t, int64(0), header)
+	require.Equal(t, int64(0), log)
+}
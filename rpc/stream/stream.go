@@ -3,6 +3,8 @@ package stream
 import (
 	"context"
 	"sync"
+
+	"github.com/ethereum/go-ethereum/metrics"
 )
 
 // Stream implements a data stream, user can subscribe the stream in blocking or non-blocking way using offsets.
@@ -14,6 +16,7 @@ type Stream[V any] struct {
 	segmentOffset int
 	cond          *Cond
 	mutex         sync.RWMutex
+	subscribers   metrics.Gauge
 }
 
 func NewStream[V any](segmentSize, capacity int) *Stream[V] {
@@ -28,10 +31,17 @@ func NewStream[V any](segmentSize, capacity int) *Stream[V] {
 		maxSegments:   maxSegments,
 		segmentOffset: 0,
 		cond:          NewCond(),
+		subscribers:   metrics.NilGauge{},
 	}
 	return stream
 }
 
+// SetSubscribersGauge sets the gauge tracking the number of active subscriptions of the stream.
+// It must be called before the stream is subscribed.
+func (s *Stream[V]) SetSubscribersGauge(g metrics.Gauge) {
+	s.subscribers = g
+}
+
 // Add appends items to the stream and returns the id of last one.
 // item id start with 1.
 func (s *Stream[V]) Add(vs ...V) int {
@@ -69,6 +79,9 @@ func (s *Stream[V]) Add(vs ...V) int {
 // it only stops if the context is canceled.
 // it returns the last id of the items.
 func (s *Stream[V]) Subscribe(ctx context.Context, callback func([]V, int) error) error {
+	s.subscribers.Inc(1)
+	defer s.subscribers.Dec(1)
+
 	var (
 		items  []V
 		offset = -1
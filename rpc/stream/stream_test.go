@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/stretchr/testify/require"
 )
 
@@ -118,3 +119,24 @@ func TestStreamReadFromEnd(t *testing.T) {
 	require.Equal(t, []int{2}, items)
 	require.Equal(t, 2, offset)
 }
+
+func TestStreamSubscribersGauge(t *testing.T) {
+	stream := NewStream[int](16, 32)
+	gauge := new(metrics.StandardGauge)
+	stream.SetSubscribersGauge(gauge)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = stream.Subscribe(ctx, func([]int, int) error { return nil })
+	}()
+
+	require.Eventually(t, func() bool {
+		return gauge.Snapshot().Value() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+	require.Equal(t, int64(0), gauge.Snapshot().Value())
+}
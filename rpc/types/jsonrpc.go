@@ -0,0 +1,114 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// MaxRequestContentLength is the max size of a JSON-RPC request body, same as the geth http server.
+const MaxRequestContentLength = 5 * 1024 * 1024
+
+// JSONRPCRequest holds the fields of a JSON-RPC request inspected by the http middlewares.
+type JSONRPCRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	// Size is the size in bytes of the request
+	Size int `json:"-"`
+}
+
+// JSONRPCBody is the body of a http JSON-RPC request with its parsed requests.
+type JSONRPCBody struct {
+	Raw      []byte
+	Requests []JSONRPCRequest
+	Batch    bool
+	// BatchRaws are the raw requests of a well-formed batch
+	BatchRaws []json.RawMessage
+	// TooLarge is true if the body exceeds MaxRequestContentLength, it's then read up to the limit
+	// and its requests are not parsed
+	TooLarge bool
+}
+
+// jsonrpcBodyKey is the context key of the JSON-RPC body of a http request.
+type jsonrpcBodyKey struct{}
+
+// ReadJSONRPCBody returns the JSON-RPC body of the http request. It's read and parsed once by the
+// outermost http middleware, the next ones get it from the context of the returned request, whose
+// body is restored for the JSON-RPC server.
+func ReadJSONRPCBody(r *http.Request) (*http.Request, *JSONRPCBody, error) {
+	if body, ok := r.Context().Value(jsonrpcBodyKey{}).(*JSONRPCBody); ok {
+		return r, body, nil
+	}
+	raw, err := io.ReadAll(io.LimitReader(r.Body, MaxRequestContentLength+1))
+	if err != nil {
+		return r, nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(raw))
+
+	body := NewJSONRPCBody(raw)
+	return WithJSONRPCBody(r, body), body, nil
+}
+
+// WithJSONRPCBody returns a shallow copy of the http request carrying the JSON-RPC body in its context.
+func WithJSONRPCBody(r *http.Request, body *JSONRPCBody) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), jsonrpcBodyKey{}, body))
+}
+
+// NewJSONRPCBody parses the JSON-RPC requests of the http request body, unless it's too large.
+func NewJSONRPCBody(raw []byte) *JSONRPCBody {
+	if len(raw) > MaxRequestContentLength {
+		return &JSONRPCBody{Raw: raw, Requests: []JSONRPCRequest{{Size: len(raw)}}, TooLarge: true}
+	}
+	return parseJSONRPCBody(raw)
+}
+
+// parseJSONRPCBody parses the single or batch JSON-RPC request of the body. The malformed requests are
+// returned empty, they are rejected later by the JSON-RPC server.
+func parseJSONRPCBody(raw []byte) *JSONRPCBody {
+	body := &JSONRPCBody{Raw: raw}
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		body.Batch = true
+		if err := json.Unmarshal(trimmed, &body.BatchRaws); err != nil {
+			body.BatchRaws = nil
+			body.Requests = []JSONRPCRequest{{}}
+			return body
+		}
+		body.Requests = make([]JSONRPCRequest, len(body.BatchRaws))
+		for i, raw := range body.BatchRaws {
+			_ = json.Unmarshal(raw, &body.Requests[i])
+			body.Requests[i].Size = len(raw)
+		}
+		return body
+	}
+
+	var req JSONRPCRequest
+	_ = json.Unmarshal(trimmed, &req)
+	req.Size = len(trimmed)
+	body.Requests = []JSONRPCRequest{req}
+	return body
+}
+
+// ParseJSONRPCRequests parses the single or batch JSON-RPC request of the body, and returns whether
+// it's a batch. The malformed requests are returned empty, they are rejected later by the JSON-RPC server.
+func ParseJSONRPCRequests(body []byte) ([]JSONRPCRequest, bool) {
+	parsed := parseJSONRPCBody(body)
+	return parsed.Requests, parsed.Batch
+}
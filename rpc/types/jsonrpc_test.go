@@ -0,0 +1,51 @@
+package types
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadJSONRPCBody(t *testing.T) {
+	batch := `[{"id":1,"method":"eth_blockNumber"},{"id":2,"method":"eth_chainId"}]`
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(batch))
+
+	r, body, err := ReadJSONRPCBody(r)
+	require.NoError(t, err)
+	require.True(t, body.Batch)
+	require.False(t, body.TooLarge)
+	require.Len(t, body.BatchRaws, 2)
+	require.Equal(t, "eth_blockNumber", body.Requests[0].Method)
+	require.Equal(t, "eth_chainId", body.Requests[1].Method)
+
+	// the next middlewares get the parsed body from the context
+	next, parsed, err := ReadJSONRPCBody(r)
+	require.NoError(t, err)
+	require.Same(t, body, parsed)
+	require.Same(t, r, next)
+
+	// the body is restored for the JSON-RPC server
+	raw, err := io.ReadAll(next.Body)
+	require.NoError(t, err)
+	require.Equal(t, batch, string(raw))
+}
+
+func TestNewJSONRPCBody(t *testing.T) {
+	body := NewJSONRPCBody([]byte(` {"id":1,"method":"eth_call"}`))
+	require.False(t, body.Batch)
+	require.Equal(t, "eth_call", body.Requests[0].Method)
+	require.Equal(t, 28, body.Requests[0].Size)
+
+	body = NewJSONRPCBody([]byte(`[{"id":1`))
+	require.True(t, body.Batch)
+	require.Empty(t, body.BatchRaws)
+	require.Len(t, body.Requests, 1)
+
+	body = NewJSONRPCBody(make([]byte, MaxRequestContentLength+1))
+	require.True(t, body.TooLarge)
+	require.Len(t, body.Requests, 1)
+}
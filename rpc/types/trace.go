@@ -0,0 +1,236 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// CallTracerName is the name of the native tracer used to build the flat traces.
+const CallTracerName = "callTracer"
+
+// Parity trace types
+const (
+	ParityTraceTypeCall    = "call"
+	ParityTraceTypeCreate  = "create"
+	ParityTraceTypeSuicide = "suicide"
+)
+
+// CallFrame is the nested call tree returned by the callTracer.
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// DecodeCallFrame decodes the result of a callTracer execution, as returned by the
+// trace queries, into a call frame.
+func DecodeCallFrame(result interface{}) (*CallFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var frame CallFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// ParityTraceAction is the action of a flat trace, its fields depend on the trace type.
+type ParityTraceAction struct {
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+	Address       *common.Address `json:"address,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// ParityTraceResult is the result of a successful call or contract creation.
+type ParityTraceResult struct {
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// ParityTrace is a single flat trace in the OpenEthereum trace format.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           *common.Hash       `json:"blockHash,omitempty"`
+	BlockNumber         *uint64            `json:"blockNumber,omitempty"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     *common.Hash       `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64            `json:"transactionPosition,omitempty"`
+	Type                string             `json:"type"`
+}
+
+// ParityTraceResults is the result of a trace_call or trace_replayBlockTransactions
+// for a single transaction. Only the "trace" trace type is supported, so the state
+// diff and VM trace are always null.
+type ParityTraceResults struct {
+	Output          hexutil.Bytes  `json:"output"`
+	StateDiff       interface{}    `json:"stateDiff"`
+	Trace           []*ParityTrace `json:"trace"`
+	VMTrace         interface{}    `json:"vmTrace"`
+	TransactionHash *common.Hash   `json:"transactionHash,omitempty"`
+}
+
+// ParityTraceContext contains the transaction fields attached to each flat trace.
+type ParityTraceContext struct {
+	BlockHash           common.Hash
+	BlockNumber         uint64
+	TransactionHash     common.Hash
+	TransactionPosition uint64
+}
+
+// FlattenCallFrame converts a callTracer call tree into the flat list of traces used by
+// the trace namespace, in depth-first order. The transaction fields are omitted if the
+// context is nil.
+func FlattenCallFrame(frame *CallFrame, txCtx *ParityTraceContext) []*ParityTrace {
+	traces := []*ParityTrace{}
+	flattenCallFrame(frame, []int{}, txCtx, &traces)
+	return traces
+}
+
+func flattenCallFrame(frame *CallFrame, traceAddress []int, txCtx *ParityTraceContext, traces *[]*ParityTrace) {
+	trace := newParityTrace(frame)
+	trace.Subtraces = len(frame.Calls)
+	trace.TraceAddress = traceAddress
+	if txCtx != nil {
+		blockHash, blockNumber := txCtx.BlockHash, txCtx.BlockNumber
+		txHash, txPosition := txCtx.TransactionHash, txCtx.TransactionPosition
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txPosition
+	}
+	*traces = append(*traces, trace)
+
+	for i := range frame.Calls {
+		childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		flattenCallFrame(&frame.Calls[i], append(childAddress, i), txCtx, traces)
+	}
+}
+
+func newParityTrace(frame *CallFrame) *ParityTrace {
+	from := frame.From
+	gas := frame.Gas
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+
+	trace := &ParityTrace{}
+	switch vm.StringToOp(frame.Type) {
+	case vm.CREATE, vm.CREATE2:
+		init := frame.Input
+		trace.Type = ParityTraceTypeCreate
+		trace.Action = ParityTraceAction{From: &from, Gas: &gas, Init: &init, Value: value}
+		if frame.Error == "" {
+			code := frame.Output
+			trace.Result = &ParityTraceResult{GasUsed: frame.GasUsed, Address: frame.To, Code: &code}
+		}
+	case vm.SELFDESTRUCT:
+		trace.Type = ParityTraceTypeSuicide
+		trace.Action = ParityTraceAction{Address: &from, RefundAddress: frame.To, Balance: value}
+	default:
+		input := frame.Input
+		trace.Type = ParityTraceTypeCall
+		trace.Action = ParityTraceAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     &from,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			output := frame.Output
+			trace.Result = &ParityTraceResult{GasUsed: frame.GasUsed, Output: &output}
+		}
+	}
+
+	trace.Error = parityTraceError(frame.Error)
+	return trace
+}
+
+// parityTraceError maps the errors reported by the callTracer to the OpenEthereum ones
+// where they differ.
+func parityTraceError(err string) string {
+	switch err {
+	case vm.ErrExecutionReverted.Error():
+		return "Reverted"
+	case vm.ErrOutOfGas.Error():
+		return "Out of gas"
+	default:
+		return err
+	}
+}
+
+// Matches returns true if the trace matches the from and to address filters of a
+// trace_filter request. An empty filter matches all the addresses.
+func (t *ParityTrace) Matches(fromAddresses, toAddresses []common.Address) bool {
+	var from, to *common.Address
+	switch t.Type {
+	case ParityTraceTypeSuicide:
+		from, to = t.Action.Address, t.Action.RefundAddress
+	case ParityTraceTypeCreate:
+		from = t.Action.From
+		if t.Result != nil {
+			to = t.Result.Address
+		}
+	default:
+		from, to = t.Action.From, t.Action.To
+	}
+	return containsAddress(fromAddresses, from) && containsAddress(toAddresses, to)
+}
+
+func containsAddress(addresses []common.Address, address *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if address == nil {
+		return false
+	}
+	for _, addr := range addresses {
+		if addr == *address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	created := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	callee := common.HexToAddress("0x4000000000000000000000000000000000000004")
+	beneficiary := common.HexToAddress("0x5000000000000000000000000000000000000005")
+
+	result := map[string]interface{}{
+		"type":    "CALL",
+		"from":    sender.Hex(),
+		"to":      contract.Hex(),
+		"value":   "0x1",
+		"gas":     "0x10000",
+		"gasUsed": "0x5000",
+		"input":   "0x01",
+		"output":  "0x02",
+		"calls": []interface{}{
+			map[string]interface{}{
+				"type":    "CREATE2",
+				"from":    contract.Hex(),
+				"to":      created.Hex(),
+				"value":   "0x0",
+				"gas":     "0x8000",
+				"gasUsed": "0x1000",
+				"input":   "0x6000",
+				"output":  "0x00",
+				"calls": []interface{}{
+					map[string]interface{}{
+						"type":  "SELFDESTRUCT",
+						"from":  created.Hex(),
+						"to":    beneficiary.Hex(),
+						"value": "0x0",
+						"gas":   "0x0",
+						"input": "0x",
+					},
+				},
+			},
+			map[string]interface{}{
+				"type":    "STATICCALL",
+				"from":    contract.Hex(),
+				"to":      callee.Hex(),
+				"gas":     "0x4000",
+				"gasUsed": "0x4000",
+				"input":   "0x03",
+				"error":   "execution reverted",
+			},
+		},
+	}
+
+	frame, err := DecodeCallFrame(result)
+	require.NoError(t, err)
+
+	txCtx := &ParityTraceContext{
+		BlockHash:           common.HexToHash("0x01"),
+		BlockNumber:         5,
+		TransactionHash:     common.HexToHash("0x02"),
+		TransactionPosition: 1,
+	}
+	traces := FlattenCallFrame(frame, txCtx)
+	require.Len(t, traces, 4)
+
+	// root call
+	require.Equal(t, ParityTraceTypeCall, traces[0].Type)
+	require.Equal(t, "call", traces[0].Action.CallType)
+	require.Equal(t, sender, *traces[0].Action.From)
+	require.Equal(t, contract, *traces[0].Action.To)
+	require.Equal(t, hexutil.Bytes{2}, *traces[0].Result.Output)
+	require.Equal(t, hexutil.Uint64(0x5000), traces[0].Result.GasUsed)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, uint64(5), *traces[0].BlockNumber)
+	require.Equal(t, uint64(1), *traces[0].TransactionPosition)
+
+	// contract creation
+	require.Equal(t, ParityTraceTypeCreate, traces[1].Type)
+	require.Equal(t, hexutil.Bytes{0x60, 0x00}, *traces[1].Action.Init)
+	require.Nil(t, traces[1].Action.To)
+	require.Equal(t, created, *traces[1].Result.Address)
+	require.Equal(t, hexutil.Bytes{0}, *traces[1].Result.Code)
+	require.Equal(t, 1, traces[1].Subtraces)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+
+	// self destruct
+	require.Equal(t, ParityTraceTypeSuicide, traces[2].Type)
+	require.Equal(t, created, *traces[2].Action.Address)
+	require.Equal(t, beneficiary, *traces[2].Action.RefundAddress)
+	require.Nil(t, traces[2].Result)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+
+	// reverted call
+	require.Equal(t, "staticcall", traces[3].Action.CallType)
+	require.Equal(t, "Reverted", traces[3].Error)
+	require.Nil(t, traces[3].Result)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+
+	// the transaction fields are omitted without context
+	bz, err := json.Marshal(FlattenCallFrame(frame, nil)[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "transactionHash")
+	require.Contains(t, string(bz), `"traceAddress":[]`)
+}
+
+func TestParityTraceMatches(t *testing.T) {
+	from := common.HexToAddress("0x01")
+	to := common.HexToAddress("0x02")
+	other := common.HexToAddress("0x03")
+
+	call := &ParityTrace{Type: ParityTraceTypeCall, Action: ParityTraceAction{From: &from, To: &to}}
+	create := &ParityTrace{
+		Type:   ParityTraceTypeCreate,
+		Action: ParityTraceAction{From: &from},
+		Result: &ParityTraceResult{Address: &to},
+	}
+	failedCreate := &ParityTrace{Type: ParityTraceTypeCreate, Action: ParityTraceAction{From: &from}}
+	suicide := &ParityTrace{Type: ParityTraceTypeSuicide, Action: ParityTraceAction{Address: &from, RefundAddress: &to}}
+
+	testCases := []struct {
+		name     string
+		trace    *ParityTrace
+		fromAddr []common.Address
+		toAddr   []common.Address
+		expMatch bool
+	}{
+		{"no filter", call, nil, nil, true},
+		{"call from", call, []common.Address{from}, nil, true},
+		{"call to", call, nil, []common.Address{other, to}, true},
+		{"call from and to", call, []common.Address{from}, []common.Address{to}, true},
+		{"call other from", call, []common.Address{other}, nil, false},
+		{"call other to", call, []common.Address{from}, []common.Address{other}, false},
+		{"create to created address", create, nil, []common.Address{to}, true},
+		{"failed create to", failedCreate, nil, []common.Address{to}, false},
+		{"suicide from and to", suicide, []common.Address{from}, []common.Address{to}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, tc.trace.Matches(tc.fromAddr, tc.toAddr))
+		})
+	}
+}
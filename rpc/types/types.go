@@ -22,8 +22,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
 	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 )
@@ -51,27 +53,33 @@ type StorageResult struct {
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
-	BlockHash        *common.Hash         `json:"blockHash"`
-	BlockNumber      *hexutil.Big         `json:"blockNumber"`
-	From             common.Address       `json:"from"`
-	Gas              hexutil.Uint64       `json:"gas"`
-	GasPrice         *hexutil.Big         `json:"gasPrice"`
-	GasFeeCap        *hexutil.Big         `json:"maxFeePerGas,omitempty"`
-	GasTipCap        *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
-	Hash             common.Hash          `json:"hash"`
-	Input            hexutil.Bytes        `json:"input"`
-	Nonce            hexutil.Uint64       `json:"nonce"`
-	To               *common.Address      `json:"to"`
-	TransactionIndex *hexutil.Uint64      `json:"transactionIndex"`
-	Value            *hexutil.Big         `json:"value"`
-	Type             hexutil.Uint64       `json:"type"`
-	Accesses         *ethtypes.AccessList `json:"accessList,omitempty"`
-	ChainID          *hexutil.Big         `json:"chainId,omitempty"`
-	V                *hexutil.Big         `json:"v"`
-	R                *hexutil.Big         `json:"r"`
-	S                *hexutil.Big         `json:"s"`
+	BlockHash           *common.Hash                    `json:"blockHash"`
+	BlockNumber         *hexutil.Big                    `json:"blockNumber"`
+	From                common.Address                  `json:"from"`
+	Gas                 hexutil.Uint64                  `json:"gas"`
+	GasPrice            *hexutil.Big                    `json:"gasPrice"`
+	GasFeeCap           *hexutil.Big                    `json:"maxFeePerGas,omitempty"`
+	GasTipCap           *hexutil.Big                    `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerBlobGas    *hexutil.Big                    `json:"maxFeePerBlobGas,omitempty"`
+	Hash                common.Hash                     `json:"hash"`
+	Input               hexutil.Bytes                   `json:"input"`
+	Nonce               hexutil.Uint64                  `json:"nonce"`
+	To                  *common.Address                 `json:"to"`
+	TransactionIndex    *hexutil.Uint64                 `json:"transactionIndex"`
+	Value               *hexutil.Big                    `json:"value"`
+	Type                hexutil.Uint64                  `json:"type"`
+	Accesses            *ethtypes.AccessList            `json:"accessList,omitempty"`
+	ChainID             *hexutil.Big                    `json:"chainId,omitempty"`
+	BlobVersionedHashes []common.Hash                   `json:"blobVersionedHashes,omitempty"`
+	AuthorizationList   []evmtypes.SetCodeAuthorization `json:"authorizationList,omitempty"`
+	V                   *hexutil.Big                    `json:"v"`
+	R                   *hexutil.Big                    `json:"r"`
+	S                   *hexutil.Big                    `json:"s"`
 }
 
+// TxPoolTransactions groups the transactions of the mempool by sender address and nonce.
+type TxPoolTransactions map[common.Address]map[uint64]*RPCTransaction
+
 // StateOverride is the collection of overridden accounts.
 type StateOverride map[common.Address]OverrideAccount
 
@@ -121,6 +129,32 @@ type BlockOverrides struct {
 	BaseFee    *hexutil.Big
 }
 
+// UnmarshalJSON accepts the field names of the execution-apis specification as well,
+// i.e. feeRecipient, prevRandao and baseFeePerGas.
+func (diff *BlockOverrides) UnmarshalJSON(input []byte) error {
+	type blockOverrides BlockOverrides
+	var dec struct {
+		blockOverrides
+		FeeRecipient  *common.Address `json:"feeRecipient"`
+		PrevRandao    *common.Hash    `json:"prevRandao"`
+		BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*diff = BlockOverrides(dec.blockOverrides)
+	if dec.FeeRecipient != nil {
+		diff.Coinbase = dec.FeeRecipient
+	}
+	if dec.PrevRandao != nil {
+		diff.Random = dec.PrevRandao
+	}
+	if dec.BaseFeePerGas != nil {
+		diff.BaseFee = dec.BaseFeePerGas
+	}
+	return nil
+}
+
 // Apply overrides the given header fields into the given block context.
 func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
 	if diff == nil {
@@ -170,6 +204,92 @@ type FeeHistoryResult struct {
 	GasUsedRatio []float64        `json:"gasUsedRatio"`
 }
 
+// SyncStatus is the sync progress of the node, the highest block is the highest block known by the node since
+// the heights of the peers are not exposed by CometBFT.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// SyncingResult is the notification of the syncing subscription when the node is catching up.
+type SyncingResult struct {
+	Syncing bool       `json:"syncing"`
+	Status  SyncStatus `json:"status"`
+}
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a simulated block, the calls are executed in order on top of the overridden state.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// AccessListResult is the result of eth_createAccessList.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// StorageRangeResult is the result of debug_storageRangeAt.
+type StorageRangeResult struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"`
+	NextKey *common.Hash                 `json:"nextKey"` // nil if Storage includes the last slot
+}
+
+// StorageEntry is a storage slot of StorageRangeResult, keyed by the hashed slot.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// AccountRangeResult is the result of debug_accountRange, the state dump of geth with the accounts of
+// AccountRangeDump.
+type AccountRangeResult struct {
+	Root     string                      `json:"root"`
+	Accounts map[string]AccountRangeDump `json:"accounts"`
+	Next     []byte                      `json:"next,omitempty"` // nil if no more accounts
+}
+
+// AccountRangeDump is an account of AccountRangeResult, its storage is capped and the rest of it is
+// paged with debug_storageRangeAt from the next storage key.
+type AccountRangeDump struct {
+	state.DumpAccount
+	NextStorageKey *common.Hash `json:"nextStorageKey,omitempty"` // nil if Storage is complete
+}
+
+// IndexerStatus is the result of debug_indexerStatus, the block numbers are -1 if the indexer is empty.
+type IndexerStatus struct {
+	FirstIndexedBlock int64                  `json:"firstIndexedBlock"`
+	LastIndexedBlock  int64                  `json:"lastIndexedBlock"`
+	Gaps              []ethermint.IndexerGap `json:"gaps"`
+}
+
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
 	Raw hexutil.Bytes         `json:"raw"`
@@ -113,10 +113,10 @@ func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Cont
 }
 
 // FormatBlock creates an ethereum block from a tendermint header and ethereum-formatted
-// transactions.
+// transactions. The blob gas used is nil before Cancun, like geth the blob gas fields are omitted then.
 func FormatBlock(
 	header tmtypes.Header, size int, gasLimit int64,
-	gasUsed *big.Int, transactions []interface{}, bloom ethtypes.Bloom,
+	gasUsed *big.Int, blobGasUsed *uint64, transactions []interface{}, bloom ethtypes.Bloom,
 	validatorAddr common.Address, baseFee *big.Int,
 ) map[string]interface{} {
 	var transactionsRoot common.Hash
@@ -154,6 +154,12 @@ func FormatBlock(
 		result["baseFeePerGas"] = (*hexutil.Big)(baseFee)
 	}
 
+	if blobGasUsed != nil {
+		result["blobGasUsed"] = hexutil.Uint64(*blobGasUsed)
+		// blobs are not kept on chain, so there is no excess blob gas to carry over
+		result["excessBlobGas"] = hexutil.Uint64(0)
+	}
+
 	return result
 }
 
@@ -190,6 +196,22 @@ func NewRPCTransaction(
 	if err != nil {
 		return nil, err
 	}
+	result := NewRPCTransactionWithSender(tx, from, blockHash, blockNumber, index, baseFee, chainID)
+	// the set code txs are carried as dynamic fee txs, which have the same fee fields
+	if setCode := msg.Raw.SetCode(); setCode != nil {
+		result.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+		result.Hash = msg.Hash()
+		result.AuthorizationList = setCode.AuthList
+	}
+	return result, nil
+}
+
+// NewRPCTransactionWithSender returns a transaction that will serialize to the RPC representation, the sender
+// is provided by the caller, so it works for unsigned transactions too.
+func NewRPCTransactionWithSender(
+	tx *ethtypes.Transaction, from common.Address, blockHash common.Hash, blockNumber, index uint64, baseFee *big.Int,
+	chainID *big.Int,
+) *RPCTransaction {
 	v, r, s := tx.RawSignatureValues()
 	result := &RPCTransaction{
 		Type:     hexutil.Uint64(tx.Type()),
@@ -216,7 +238,7 @@ func NewRPCTransaction(
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
-	case ethtypes.DynamicFeeTxType:
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType:
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
@@ -230,8 +252,12 @@ func NewRPCTransaction(
 		} else {
 			result.GasPrice = (*hexutil.Big)(tx.GasFeeCap())
 		}
+		if tx.Type() == ethtypes.BlobTxType {
+			result.MaxFeePerBlobGas = (*hexutil.Big)(tx.BlobGasFeeCap())
+			result.BlobVersionedHashes = tx.BlobHashes()
+		}
 	}
-	return result, nil
+	return result
 }
 
 // BaseFeeFromEvents parses the feemarket basefee from cosmos events
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatBlockBlobGas(t *testing.T) {
+	header := tmtypes.Header{Height: 1}
+	format := func(blobGasUsed *uint64) map[string]interface{} {
+		return FormatBlock(header, 0, 0, big.NewInt(0), blobGasUsed, nil, ethtypes.Bloom{}, common.Address{}, nil)
+	}
+
+	// before Cancun
+	block := format(nil)
+	require.NotContains(t, block, "blobGasUsed")
+	require.NotContains(t, block, "excessBlobGas")
+
+	blobGasUsed := uint64(131072)
+	block = format(&blobGasUsed)
+	require.Equal(t, hexutil.Uint64(blobGasUsed), block["blobGasUsed"])
+	require.Equal(t, hexutil.Uint64(0), block["excessBlobGas"])
+}
@@ -16,23 +16,22 @@
 package rpc
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
-	"math/big"
-	"net"
+	"errors"
 	"net/http"
-	"strconv"
+	"net/url"
+	"strings"
 	"sync"
+	"time"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
-	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -40,75 +39,84 @@ import (
 	"cosmossdk.io/log"
 
 	rpcfilters "github.com/Helios-Chain-Labs/ethermint/rpc/namespaces/ethereum/eth/filters"
+	rpcmetrics "github.com/Helios-Chain-Labs/ethermint/rpc/metrics"
+	"github.com/Helios-Chain-Labs/ethermint/rpc/ratelimit"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/stream"
+	"github.com/Helios-Chain-Labs/ethermint/rpc/types"
 	"github.com/Helios-Chain-Labs/ethermint/server/config"
 )
 
+// syncingPollInterval is the interval to poll the node status for the syncing subscription
+const syncingPollInterval = time.Second
+
 type WebsocketsServer interface {
 	Start()
 }
 
-type SubscriptionResponseJSON struct {
-	Jsonrpc string      `json:"jsonrpc"`
-	Result  interface{} `json:"result"`
-	ID      float64     `json:"id"`
-}
-
-type SubscriptionNotification struct {
-	Jsonrpc string              `json:"jsonrpc"`
-	Method  string              `json:"method"`
-	Params  *SubscriptionResult `json:"params"`
-}
-
-type SubscriptionResult struct {
-	Subscription rpc.ID      `json:"subscription"`
-	Result       interface{} `json:"result"`
-}
-
-type ErrorResponseJSON struct {
-	Jsonrpc string            `json:"jsonrpc"`
-	Error   *ErrorMessageJSON `json:"error"`
-	ID      *big.Int          `json:"id"`
-}
-
-type ErrorMessageJSON struct {
-	Code    *big.Int `json:"code"`
-	Message string   `json:"message"`
-}
-
 type websocketsServer struct {
-	rpcAddr  string // listen address of rest-server
-	wsAddr   string // listen address of ws server
-	certFile string
-	keyFile  string
-	api      *pubSubAPI
-	logger   log.Logger
+	wsAddr         string // listen address of ws server
+	certFile       string
+	keyFile        string
+	rpcServer      *rpc.Server
+	upgrader       websocket.Upgrader
+	readTimeout    time.Duration
+	writeTimeout   time.Duration
+	maxMessageSize int64
+	// limiter applies the limits of the http server to the messages, nil if disabled
+	limiter *ratelimit.Limiter
+	logger  log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+// NewWebsocketsServer creates the websocket server, the JSON-RPC messages are dispatched to the
+// given rpc server, on which the eth subscriptions are registered. The messages are limited by
+// the given limiter, if any.
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	rpcServer *rpc.Server,
+	stream *stream.RPCStream,
+	limiter *ratelimit.Limiter,
+	cfg *config.Config,
+) (WebsocketsServer, error) {
 	logger = logger.With("api", "websocket-server")
-	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
-	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, stream),
-		logger:   logger,
+	if err := rpcServer.RegisterName(EthNamespace, newPubSubAPI(clientCtx, logger, stream)); err != nil {
+		return nil, err
 	}
+
+	return &websocketsServer{
+		wsAddr:    cfg.JSONRPC.WsAddress,
+		certFile:  cfg.TLS.CertificatePath,
+		keyFile:   cfg.TLS.KeyPath,
+		rpcServer: rpcServer,
+		upgrader: websocket.Upgrader{
+			HandshakeTimeout: cfg.JSONRPC.WsWriteTimeout,
+			CheckOrigin:      checkOrigin(cfg.JSONRPC.WsOrigins),
+		},
+		readTimeout:    cfg.JSONRPC.WsReadTimeout,
+		writeTimeout:   cfg.JSONRPC.WsWriteTimeout,
+		maxMessageSize: cfg.JSONRPC.WsMaxMessageSize,
+		limiter:        limiter,
+		logger:         logger,
+	}, nil
 }
 
 func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
 
+	srv := &http.Server{
+		Addr:              s.wsAddr,
+		Handler:           ws,
+		ReadHeaderTimeout: s.readTimeout,
+	}
+
 	go func() {
 		var err error
 		if s.certFile == "" || s.keyFile == "" {
-			err = http.ListenAndServe(s.wsAddr, ws) // #nosec G114 -- http functions have no support for timeouts
+			err = srv.ListenAndServe()
 		} else {
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws) // #nosec G114 -- http functions have no support for timeouts
+			err = srv.ListenAndServeTLS(s.certFile, s.keyFile)
 		}
 		if err != nil {
 			if err == http.ErrServerClosed {
@@ -121,230 +129,149 @@ func (s *websocketsServer) Start() {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	upgrader := websocket.Upgrader{
-		CheckOrigin: func(_ *http.Request) bool {
-			return true
-		},
-	}
-
-	conn, err := upgrader.Upgrade(w, r, nil)
+	conn, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		s.logger.Debug("websocket upgrade failed", "error", err.Error())
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
-}
-
-func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
-	res := &ErrorResponseJSON{
-		Jsonrpc: "2.0",
-		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
-			Message: msg,
-		},
-		ID: nil,
+	var limits *ratelimit.Conn
+	if s.limiter != nil {
+		limits = s.limiter.NewConn(r)
+		defer limits.Close()
 	}
-
-	_ = wsConn.WriteJSON(res)
-}
-
-type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
-}
-
-func (w *wsConn) WriteJSON(v interface{}) error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
-
-	return w.conn.WriteJSON(v)
-}
-
-func (w *wsConn) Close() error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
-
-	return w.conn.Close()
-}
-
-func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
-	// not protected by write mutex
-
-	return w.conn.ReadMessage()
+	s.serveConn(conn, limits)
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
-	// subscriptions of current connection
-	subscriptions := make(map[rpc.ID]context.CancelFunc)
-	defer func() {
-		// cancel all subscriptions when connection closed
-		for _, unsubFn := range subscriptions {
-			unsubFn()
-		}
-	}()
-
-	for {
-		_, mb, err := wsConn.ReadMessage()
-		if err != nil {
-			_ = wsConn.Close()
-			s.logger.Error("read message error, breaking read loop", "error", err.Error())
-			return
-		}
-
-		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
-			continue
-		}
-
-		var msg map[string]interface{}
-		if err = json.Unmarshal(mb, &msg); err != nil {
-			s.sendErrResponse(wsConn, err.Error())
-			continue
-		}
-
-		// check if method == eth_subscribe or eth_unsubscribe
-		method, ok := msg["method"].(string)
-		if !ok {
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+// serveConn serves the JSON-RPC messages of the connection until it's closed.
+// The read deadline is extended by every message and pong received, the connection is pinged
+// so that idle subscribers are not dropped. The messages rejected by the limits, if any, are
+// answered without being dispatched to the rpc server.
+func (s *websocketsServer) serveConn(conn *websocket.Conn, limits *ratelimit.Conn) {
+	if s.maxMessageSize > 0 {
+		conn.SetReadLimit(s.maxMessageSize)
+	}
 
-			continue
+	extendReadDeadline := func() error {
+		if s.readTimeout == 0 {
+			return nil
 		}
+		return conn.SetReadDeadline(time.Now().Add(s.readTimeout))
+	}
+	conn.SetPongHandler(func(string) error {
+		return extendReadDeadline()
+	})
 
-		var connID float64
-		switch id := msg["id"].(type) {
-		case string:
-			connID, err = strconv.ParseFloat(id, 64)
-		case float64:
-			connID = id
-		default:
-			err = fmt.Errorf("unknown type")
+	// the calls are recorded from their read to the write of their response, the rejected ones aren't
+	calls := rpcmetrics.NewConn()
+
+	// the rejections are written from the read loop, concurrently with the responses
+	var writeMu sync.Mutex
+	write := func(msg []byte) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		// overrides the default write deadline of the codec
+		if err := conn.SetWriteDeadline(s.writeDeadline()); err != nil {
+			return err
 		}
+		return conn.WriteMessage(websocket.TextMessage, msg)
+	}
+	encode := func(v interface{}, _ bool) error {
+		msg, err := json.Marshal(v)
 		if err != nil {
-			s.sendErrResponse(
-				wsConn,
-				fmt.Errorf("invalid type for connection ID: %T", msg["id"]).Error(),
-			)
-			continue
+			return err
 		}
-
-		switch method {
-		case "eth_subscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
-
-			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
-			if err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				continue
+		if limits != nil {
+			defer limits.Written(msg)
+		}
+		defer calls.Written(msg)
+		return write(msg)
+	}
+	decode := func(v interface{}) error {
+		for {
+			if err := extendReadDeadline(); err != nil {
+				return err
 			}
-			subscriptions[subID] = unsubFn
-
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  subID,
+			if limits == nil && calls == nil {
+				return conn.ReadJSON(v)
 			}
-
-			if err := wsConn.WriteJSON(res); err != nil {
-				break
+			var msg json.RawMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return err
 			}
-		case "eth_unsubscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
+			if limits != nil {
+				if resp := limits.Admit(msg); resp != nil {
+					if err := write(resp); err != nil {
+						return err
+					}
+					continue
+				}
 			}
+			calls.Read(msg)
+			return json.Unmarshal(msg, v)
+		}
+	}
 
-			id, ok := params[0].(string)
-			if !ok {
-				s.sendErrResponse(wsConn, "invalid parameters")
-				continue
-			}
+	done := make(chan struct{})
+	defer close(done)
+	if s.readTimeout > 0 {
+		go s.pingLoop(conn, done)
+	}
 
-			subID := rpc.ID(id)
-			unsubFn, ok := subscriptions[subID]
-			if ok {
-				delete(subscriptions, subID)
-				unsubFn()
-			}
+	// it blocks until the connection is closed, the subscriptions are canceled on return
+	s.rpcServer.ServeCodec(rpc.NewFuncCodec(conn, encode, decode), 0)
+}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  ok,
-			}
+// pingLoop pings the peer periodically within the read timeout, until done is closed.
+func (s *websocketsServer) pingLoop(conn *websocket.Conn, done <-chan struct{}) {
+	ticker := time.NewTicker(s.readTimeout * 9 / 10)
+	defer ticker.Stop()
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				break
-			}
-		default:
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, s.writeDeadline()); err != nil {
+				s.logger.Debug("failed to ping websocket peer", "error", err.Error())
+				return
 			}
 		}
 	}
 }
 
-// tcpGetAndSendResponse sends error response to client if params is invalid
-func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, wsConn *wsConn) ([]interface{}, bool) {
-	params, ok := msg["params"].([]interface{})
-	if !ok {
-		s.sendErrResponse(wsConn, "invalid parameters")
-		return nil, false
-	}
-
-	if len(params) == 0 {
-		s.sendErrResponse(wsConn, "empty parameters")
-		return nil, false
+// writeDeadline returns the deadline of a write started now, zero means no deadline.
+func (s *websocketsServer) writeDeadline() time.Time {
+	if s.writeTimeout == 0 {
+		return time.Time{}
 	}
-
-	return params, true
+	return time.Now().Add(s.writeTimeout)
 }
 
-// tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
-	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
-	if err != nil {
-		return errors.Wrap(err, "Could not build request")
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "Could not perform request")
-	}
-
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrap(err, "could not read body from response")
+// checkOrigin returns the origin check of the websocket handshake.
+// Requests without an Origin header are sent by non-browser clients and are always accepted,
+// "*" accepts all the origins and an empty list only accepts the same origin as the host.
+func checkOrigin(allowedOrigins []string) func(r *http.Request) bool {
+	origins := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		origins[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
 	}
 
-	var wsSend interface{}
-	err = json.Unmarshal(body, &wsSend)
-	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal rest-server response")
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || origins["*"] {
+			return true
+		}
+		if len(origins) == 0 {
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		}
+		return origins[strings.ToLower(origin)]
 	}
-
-	return wsConn.WriteJSON(wsSend)
 }
 
-// pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
+// pubSubAPI is the eth_subscribe set of APIs in the Web3 JSON-RPC spec,
+// the subscriptions are only available on the websocket connections.
 type pubSubAPI struct {
 	events    *stream.RPCStream
 	logger    log.Logger
@@ -361,250 +288,168 @@ func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RP
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (context.CancelFunc, error) {
-	method, ok := params[0].(string)
-	if !ok {
-		return nil, errors.New("invalid parameters")
-	}
-
-	switch method {
-	case "newHeads":
-		// TODO: handle extra params
-		return api.subscribeNewHeads(wsConn, subID)
-	case "logs":
-		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
-		}
-		return api.subscribeLogs(wsConn, subID, nil)
-	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
-	case "syncing":
-		return api.subscribeSyncing(wsConn, subID)
-	default:
-		return nil, errors.Errorf("unsupported method %s", method)
+// NewHeads sends a notification each time a new block header is appended to the chain.
+func (api *pubSubAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, rpcSub, err := newSubscription(ctx)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
-	ctx, cancel := context.WithCancel(context.Background())
+	subCtx := subscriptionContext(rpcSub)
 	//nolint: errcheck
-	go api.events.HeaderStream().Subscribe(ctx, func(headers []stream.RPCHeader, _ int) error {
+	go api.events.HeaderStream().Subscribe(subCtx, func(headers []stream.RPCHeader, _ int) error {
 		for _, header := range headers {
-			// write to ws conn
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       header.EthHeader,
-				},
-			}
-
-			if err := wsConn.WriteJSON(res); err != nil {
-				api.logger.Error("error writing header, will drop peer", "error", err.Error())
-
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
-					}
-				}, api.logger, "closing websocket peer sub")
+			if err := notifier.Notify(rpcSub.ID, header.EthHeader); err != nil {
+				api.logger.Debug("error writing header, will drop subscription", "error", err.Error())
 				return err
 			}
 		}
 		return nil
 	})
 
-	return cancel, nil
+	return rpcSub, nil
 }
 
-func try(fn func(), l log.Logger, desc string) {
-	defer func() {
-		if x := recover(); x != nil {
-			if err, ok := x.(error); ok {
-				// debug.PrintStack()
-				l.Debug("panic during "+desc, "error", err.Error())
-				return
-			}
+// Logs sends a notification for each new log matching the addresses and topics of the criteria.
+func (api *pubSubAPI) Logs(ctx context.Context, crit *filters.FilterCriteria) (*rpc.Subscription, error) {
+	notifier, rpcSub, err := newSubscription(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if crit == nil {
+		crit = &filters.FilterCriteria{}
+	}
 
-			l.Debug(fmt.Sprintf("panic during %s: %+v", desc, x))
-			return
+	subCtx := subscriptionContext(rpcSub)
+	//nolint: errcheck
+	go api.events.LogStream().Subscribe(subCtx, func(txLogs []*ethtypes.Log, _ int) error {
+		for _, ethLog := range rpcfilters.FilterLogs(txLogs, nil, nil, crit.Addresses, crit.Topics) {
+			if err := notifier.Notify(rpcSub.ID, ethLog); err != nil {
+				api.logger.Debug("error writing log, will drop subscription", "error", err.Error())
+				return err
+			}
 		}
-	}()
+		return nil
+	})
 
-	fn()
+	return rpcSub, nil
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (context.CancelFunc, error) {
-	crit := filters.FilterCriteria{}
-
-	if extra != nil {
-		params, ok := extra.(map[string]interface{})
-		if !ok {
-			err := errors.New("invalid criteria")
-			api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
-			return nil, err
-		}
-
-		if params["address"] != nil {
-			switch address := params["address"].(type) {
-			case string:
-				crit.Addresses = []common.Address{common.HexToAddress(address)}
-			case []interface{}:
-				for _, addr := range address {
-					address, ok := addr.(string)
-					if !ok {
-						return nil, errors.New("invalid address")
-					}
+// NewPendingTransactions sends a notification with the hash of each transaction entering the mempool.
+func (api *pubSubAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, rpcSub, err := newSubscription(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-					crit.Addresses = append(crit.Addresses, common.HexToAddress(address))
-				}
-			default:
-				return nil, errors.New("invalid addresses; must be address or array of addresses")
+	subCtx := subscriptionContext(rpcSub)
+	//nolint: errcheck
+	go api.events.PendingTxStream().Subscribe(subCtx, func(items []common.Hash, _ int) error {
+		for _, hash := range items {
+			if err := notifier.Notify(rpcSub.ID, hash); err != nil {
+				api.logger.Debug("error writing pending tx, will drop subscription", "error", err.Error())
+				return err
 			}
 		}
+		return nil
+	})
 
-		if params["topics"] != nil {
-			topics, ok := params["topics"].([]interface{})
-			if !ok {
-				err := errors.Errorf("invalid topics: %s", topics)
-				api.logger.Error("invalid topics", "type", fmt.Sprintf("%T", topics))
-				return nil, err
-			}
-
-			crit.Topics = make([][]common.Hash, len(topics))
-
-			addCritTopic := func(topicIdx int, topic interface{}) error {
-				tstr, ok := topic.(string)
-				if !ok {
-					err := errors.Errorf("invalid topic: %s", topic)
-					api.logger.Error("invalid topic", "type", fmt.Sprintf("%T", topic))
-					return err
-				}
-
-				crit.Topics[topicIdx] = []common.Hash{common.HexToHash(tstr)}
-				return nil
-			}
+	return rpcSub, nil
+}
 
-			for topicIdx, subtopics := range topics {
-				if subtopics == nil {
-					continue
-				}
+// Syncing sends a notification each time the sync status of the node changes.
+func (api *pubSubAPI) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a node client")
+	}
+	notifier, rpcSub, err := newSubscription(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-				// in case we don't have list, but a single topic value
-				if topic, ok := subtopics.(string); ok {
-					if err := addCritTopic(topicIdx, topic); err != nil {
-						return nil, err
-					}
+	subCtx := subscriptionContext(rpcSub)
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
 
-					continue
+		var last *types.SyncStatus
+		for {
+			res, err := api.clientCtx.Client.Status(subCtx)
+			if err != nil {
+				if subCtx.Err() != nil {
+					return
 				}
-
-				// in case we actually have a list of subtopics
-				subtopicsList, ok := subtopics.([]interface{})
-				if !ok {
-					err := errors.New("invalid subtopics")
-					api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopics))
-					return nil, err
+				api.logger.Debug("failed to get node status", "error", err.Error())
+			} else if status := syncStatus(last, res.SyncInfo); !equalSyncStatus(last, status) {
+				// like geth, notify false once the node is synced
+				var result interface{} = false
+				if status != nil {
+					result = &types.SyncingResult{Syncing: true, Status: *status}
 				}
 
-				subtopicsCollect := make([]common.Hash, len(subtopicsList))
-				for idx, subtopic := range subtopicsList {
-					tstr, ok := subtopic.(string)
-					if !ok {
-						err := errors.Errorf("invalid subtopic: %s", subtopic)
-						api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopic))
-						return nil, err
-					}
-
-					subtopicsCollect[idx] = common.HexToHash(tstr)
+				if err := notifier.Notify(rpcSub.ID, result); err != nil {
+					api.logger.Debug("error writing syncing status, will drop subscription", "error", err.Error())
+					return
 				}
-
-				crit.Topics[topicIdx] = subtopicsCollect
-			}
-		}
-	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.LogStream().Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
-		logs := rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-		if len(logs) == 0 {
-			return nil
-		}
-
-		for _, ethLog := range logs {
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       ethLog,
-				},
+				last = status
 			}
 
-			err := wsConn.WriteJSON(res)
-			if err != nil {
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
-					}
-				}, api.logger, "closing websocket peer sub")
-
-				return err
+			select {
+			case <-subCtx.Done():
+				return
+			case <-ticker.C:
 			}
 		}
-		return nil
-	})
+	}()
 
-	return cancel, nil
+	return rpcSub, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
-		for _, hash := range items {
-			// write to ws conn
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       hash,
-				},
-			}
-
-			err := wsConn.WriteJSON(res)
-			if err != nil {
-				api.logger.Debug("error writing header, will drop peer", "error", err.Error())
-
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
-					}
-				}, api.logger, "closing websocket peer sub")
-				return err
-			}
-		}
-		return nil
-	})
-
-	return cancel, nil
+// newSubscription creates a subscription on the connection of the request.
+func newSubscription(ctx context.Context) (*rpc.Notifier, *rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, nil, rpc.ErrNotificationsUnsupported
+	}
+	return notifier, notifier.CreateSubscription(), nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// subscriptionContext returns a context which is canceled once the subscription is
+// unsubscribed or its connection is closed.
+func subscriptionContext(rpcSub *rpc.Subscription) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-rpcSub.Err()
+		cancel()
+	}()
+	return ctx
 }
 
-// copy from github.com/ethereum/go-ethereum/rpc/json.go
-// isBatch returns true when the first non-whitespace characters is '['
-func isBatch(raw []byte) bool {
-	for _, c := range raw {
-		// skip insignificant whitespace (http://www.ietf.org/rfc/rfc4627.txt)
-		if c == 0x20 || c == 0x09 || c == 0x0a || c == 0x0d {
-			continue
+// syncStatus returns the sync progress of the node, nil if it's not catching up. The starting block is the height
+// of the node when it started catching up, it's kept from the last status until the node is synced. The highest
+// block is the highest height the node has reached during the sync, it never goes back.
+func syncStatus(last *types.SyncStatus, info coretypes.SyncInfo) *types.SyncStatus {
+	if !info.CatchingUp {
+		return nil
+	}
+	current := hexutil.Uint64(info.LatestBlockHeight)
+	starting, highest := current, current
+	if last != nil {
+		starting = last.StartingBlock
+		if last.HighestBlock > highest {
+			highest = last.HighestBlock
 		}
-		return c == '['
 	}
-	return false
+	return &types.SyncStatus{
+		StartingBlock: starting,
+		CurrentBlock:  current,
+		HighestBlock:  highest,
+	}
+}
+
+func equalSyncStatus(a, b *types.SyncStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Helios-Chain-Labs/ethermint/rpc/ratelimit"
+	"github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	"github.com/Helios-Chain-Labs/ethermint/server/config"
+)
+
+type echoAPI struct{}
+
+func (echoAPI) Echo(s string) string {
+	return s
+}
+
+func TestWebsocketsServer(t *testing.T) {
+	rpcServer := rpc.NewServer()
+	defer rpcServer.Stop()
+	require.NoError(t, rpcServer.RegisterName("test", echoAPI{}))
+
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.WsOrigins = []string{"http://allowed.com"}
+	cfg.JSONRPC.WsReadTimeout = time.Second
+	cfg.JSONRPC.WsMaxMessageSize = 1024
+	wsSrv, err := NewWebsocketsServer(client.Context{}, log.NewNopLogger(), rpcServer, nil, nil, cfg)
+	require.NoError(t, err)
+
+	httpSrv := httptest.NewServer(wsSrv.(*websocketsServer))
+	defer httpSrv.Close()
+	endpoint := "ws" + strings.TrimPrefix(httpSrv.URL, "http")
+	ctx := context.Background()
+
+	// requests are served in-process by the rpc server
+	c, err := rpc.DialWebsocket(ctx, endpoint, "")
+	require.NoError(t, err)
+	var res string
+	require.NoError(t, c.CallContext(ctx, &res, "test_echo", "hello"))
+	require.Equal(t, "hello", res)
+
+	// the connection is kept alive by the pings past the read timeout
+	time.Sleep(2 * cfg.JSONRPC.WsReadTimeout)
+	require.NoError(t, c.CallContext(ctx, &res, "test_echo", "again"))
+	require.Equal(t, "again", res)
+
+	// the subscriptions are registered on the eth namespace
+	_, err = c.Subscribe(ctx, EthNamespace, make(chan interface{}), "syncing")
+	require.ErrorContains(t, err, "syncing subscription requires a node client")
+
+	// the messages over the max size close the connection
+	err = c.CallContext(ctx, &res, "test_echo", strings.Repeat("a", 2048))
+	require.Error(t, err)
+	c.Close()
+
+	c, err = rpc.DialWebsocket(ctx, endpoint, "http://allowed.com")
+	require.NoError(t, err)
+	c.Close()
+
+	_, err = rpc.DialWebsocket(ctx, endpoint, "http://other.com")
+	require.Error(t, err)
+}
+
+// blockingAPI blocks its calls until released.
+type blockingAPI struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (api blockingAPI) Block() string {
+	api.started <- struct{}{}
+	<-api.release
+	return "done"
+}
+
+func TestWebsocketsLimits(t *testing.T) {
+	rpcServer := rpc.NewServer()
+	defer rpcServer.Stop()
+	api := blockingAPI{started: make(chan struct{}), release: make(chan struct{})}
+	require.NoError(t, rpcServer.RegisterName("test", echoAPI{}))
+	require.NoError(t, rpcServer.RegisterName("heavy", api))
+
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.RateLimitPerSecond = 0.001
+	cfg.JSONRPC.RateLimitBurst = 10
+	cfg.JSONRPC.MaxConcurrentHeavyCalls = 1
+	cfg.JSONRPC.MethodWeights = []string{"heavy_block=4"}
+	limiter, err := ratelimit.NewLimiter(cfg.JSONRPC, log.NewNopLogger())
+	require.NoError(t, err)
+	wsSrv, err := NewWebsocketsServer(client.Context{}, log.NewNopLogger(), rpcServer, nil, limiter, cfg)
+	require.NoError(t, err)
+
+	httpSrv := httptest.NewServer(wsSrv.(*websocketsServer))
+	defer httpSrv.Close()
+	endpoint := "ws" + strings.TrimPrefix(httpSrv.URL, "http")
+	ctx := context.Background()
+	c, err := rpc.DialWebsocket(ctx, endpoint, "")
+	require.NoError(t, err)
+	defer c.Close()
+
+	// the heavy calls are limited across the connections
+	done := make(chan error)
+	go func() {
+		var res string
+		done <- c.CallContext(ctx, &res, "heavy_block")
+	}()
+	<-api.started
+	other, err := rpc.DialWebsocket(ctx, endpoint, "")
+	require.NoError(t, err)
+	defer other.Close()
+	var res string
+	err = other.CallContext(ctx, &res, "heavy_block")
+	require.ErrorContains(t, err, "too many concurrent heavy requests")
+	close(api.release)
+	require.NoError(t, <-done)
+
+	// the slot is released once the response is written, the connections of the client spent 4 + 4 tokens
+	go func() { <-api.started }()
+	require.NoError(t, other.CallContext(ctx, &res, "heavy_block"))
+	require.Equal(t, "done", res)
+	require.NoError(t, c.CallContext(ctx, &res, "test_echo", "hello"))
+	require.NoError(t, c.CallContext(ctx, &res, "test_echo", "hello"))
+
+	// the messages are rate limited with the bucket of the client, shared by its connections
+	err = c.CallContext(ctx, &res, "test_echo", "hello")
+	require.ErrorContains(t, err, "rate limit exceeded")
+}
+
+func TestCheckOrigin(t *testing.T) {
+	testCases := []struct {
+		name    string
+		allowed []string
+		origin  string
+		expPass bool
+	}{
+		{"no origin header", []string{"http://allowed.com"}, "", true},
+		{"allowed origin", []string{"http://allowed.com/"}, "http://Allowed.com", true},
+		{"not allowed origin", []string{"http://allowed.com"}, "http://other.com", false},
+		{"wildcard", []string{"*"}, "http://other.com", true},
+		{"same origin", nil, "http://localhost:8546", true},
+		{"cross origin", nil, "http://other.com", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://localhost:8546", nil)
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+			require.Equal(t, tc.expPass, checkOrigin(tc.allowed)(r))
+		})
+	}
+}
+
+func TestSyncStatus(t *testing.T) {
+	// successive polls of the node status, with the expected status and whether it's notified
+	testCases := []struct {
+		name       string
+		info       coretypes.SyncInfo
+		expStatus  *types.SyncStatus
+		expChanged bool
+	}{
+		{"synced", coretypes.SyncInfo{LatestBlockHeight: 5}, nil, false},
+		{"starts catching up", coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 10}, &types.SyncStatus{StartingBlock: 10, CurrentBlock: 10, HighestBlock: 10}, true},
+		{
+			"same height", coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 10},
+			&types.SyncStatus{StartingBlock: 10, CurrentBlock: 10, HighestBlock: 10}, false,
+		},
+		{
+			"keeps the starting block", coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 20},
+			&types.SyncStatus{StartingBlock: 10, CurrentBlock: 20, HighestBlock: 20}, true,
+		},
+		{
+			"keeps the highest block", coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 15},
+			&types.SyncStatus{StartingBlock: 10, CurrentBlock: 15, HighestBlock: 20}, true,
+		},
+		{"synced again", coretypes.SyncInfo{LatestBlockHeight: 30}, nil, true},
+		{
+			"catches up from the new height", coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 30},
+			&types.SyncStatus{StartingBlock: 30, CurrentBlock: 30, HighestBlock: 30}, true,
+		},
+	}
+	var last *types.SyncStatus
+	for _, tc := range testCases {
+		status := syncStatus(last, tc.info)
+		require.Equal(t, tc.expStatus, status, tc.name)
+		require.Equal(t, tc.expChanged, !equalSyncStatus(last, status), tc.name)
+		last = status
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"runtime"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -44,6 +45,9 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultJSONRPCAuthAddress is the default address the authenticated JSON-RPC server binds to.
+	DefaultJSONRPCAuthAddress = "127.0.0.1:8551"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -74,6 +78,22 @@ const (
 
 	DefaultHTTPIdleTimeout = 120 * time.Second
 
+	DefaultWsReadTimeout = 60 * time.Second
+
+	DefaultWsWriteTimeout = 10 * time.Second
+
+	// DefaultRateLimitBurst is the default number of request tokens a client can spend at once
+	DefaultRateLimitBurst = 100
+
+	// DefaultWsMaxMessageSize is the default max size in bytes of a message read from a websocket connection
+	DefaultWsMaxMessageSize = 32 * 1024 * 1024
+
+	// DefaultBatchRequestLimit is the default max number of requests in a batch, same as geth
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultBatchResponseMaxSize is the default max size in bytes of the responses of a batch, same as geth
+	DefaultBatchResponseMaxSize = 25 * 1000 * 1000
+
 	// DefaultAllowUnprotectedTxs value is false
 	DefaultAllowUnprotectedTxs = false
 
@@ -100,6 +120,11 @@ const (
 
 	BlockExecutorSequential = "sequential"
 	BlockExecutorBlockSTM   = "block-stm"
+
+	// IndexerBackendKV stores the custom tx indexer in a KV db using the backend of the main app
+	IndexerBackendKV = "kv"
+	// IndexerBackendSQL stores the custom tx indexer in an embedded sqlite database
+	IndexerBackendSQL = "sql"
 )
 
 var (
@@ -109,6 +134,10 @@ var (
 	evmTracers = []string{"json", "markdown", "struct", "access_list", "firehose"}
 
 	blockExecutors = []string{BlockExecutorSequential, BlockExecutorBlockSTM}
+
+	// DefaultBatchConcurrency is the default number of read-only requests of the batches served at the same time,
+	// one per CPU, and at least 2 so the batches are served concurrently
+	DefaultBatchConcurrency = max(runtime.NumCPU(), 2)
 )
 
 // Config defines the server's top level configuration. It includes the default app config
@@ -143,9 +172,17 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
-	// GasCap is the global gas cap for eth-call variants.
+	// AuthEnable defines if the JWT authenticated JSON-RPC server should be enabled.
+	AuthEnable bool `mapstructure:"auth-enable"`
+	// AuthAddress defines the JWT authenticated HTTP server to listen on
+	AuthAddress string `mapstructure:"auth-address"`
+	// AuthAPI defines a list of JSON-RPC namespaces served by the JWT authenticated server
+	AuthAPI []string `mapstructure:"auth-api"`
+	// AuthJWTSecret is the path of the hex encoded JWT secret file, generated if it doesn't exist.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
+	// GasCap is the global gas cap for eth-call variants and the mempool txs of the pending queries.
 	GasCap uint64 `mapstructure:"gas-cap"`
-	// EVMTimeout is the global timeout for eth-call.
+	// EVMTimeout is the global timeout for eth-call and the pending queries.
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
@@ -163,6 +200,36 @@ type JSONRPCConfig struct {
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
 	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+	// WsOrigins defines the origins allowed to open websocket connections, "*" allows all.
+	WsOrigins []string `mapstructure:"ws-origins"`
+	// WsReadTimeout is the max duration a websocket connection waits for the next message or pong.
+	WsReadTimeout time.Duration `mapstructure:"ws-read-timeout"`
+	// WsWriteTimeout is the write timeout of the websocket messages.
+	WsWriteTimeout time.Duration `mapstructure:"ws-write-timeout"`
+	// WsMaxMessageSize is the max size in bytes of a message read from a websocket connection.
+	WsMaxMessageSize int64 `mapstructure:"ws-max-message-size"`
+	// RateLimitPerSecond is the number of request tokens refilled per second for each client, 0 disables the rate limit.
+	RateLimitPerSecond float64 `mapstructure:"rate-limit-per-second"`
+	// RateLimitBurst is the max number of request tokens a client can spend at once, the batches costing
+	// more are rejected with an invalid request error.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// RateLimitAPIKeys defines the API keys rate limited separately from the client IP.
+	RateLimitAPIKeys []string `mapstructure:"rate-limit-api-keys"`
+	// RateLimitTrustedProxies defines the IPs or CIDRs of the reverse proxies the client IP is read from,
+	// with the X-Forwarded-For or X-Real-IP headers.
+	RateLimitTrustedProxies []string `mapstructure:"rate-limit-trusted-proxies"`
+	// MethodWeights defines the request tokens spent by the methods, in the method=weight format.
+	MethodWeights []string `mapstructure:"method-weights"`
+	// BatchRequestLimit is the max number of requests in a batch, 0 means unlimited.
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize is the max size in bytes of the responses of a batch, 0 means unlimited.
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
+	// BatchConcurrency is the number of read-only requests of the batches served at the same time,
+	// the batches are served sequentially if it's lower than 2.
+	BatchConcurrency int `mapstructure:"batch-concurrency"`
+	// MaxConcurrentHeavyCalls is the max number of requests with heavy methods served at the same time,
+	// 0 means unlimited.
+	MaxConcurrentHeavyCalls int `mapstructure:"max-concurrent-heavy-calls"`
 	// AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 	// the node's RPC when global parameter is disabled.
 	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
@@ -173,6 +240,12 @@ type JSONRPCConfig struct {
 	EnableIndexer bool `mapstructure:"enable-indexer"`
 	// AllowIndexerGap defines if allow block gap for the custom indexer service.
 	AllowIndexerGap bool `mapstructure:"allow-indexer-gap"`
+	// IndexerBackend defines the storage of the custom indexer service, either kv or sql.
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerRetainBlocks defines the number of recent blocks kept by the custom indexer service, 0 keeps all.
+	IndexerRetainBlocks int64 `mapstructure:"indexer-retain-blocks"`
+	// IndexerFullReceipts defines if the custom indexer service stores the full receipts of the eth txs.
+	IndexerFullReceipts bool `mapstructure:"indexer-full-receipts"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -271,7 +344,13 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace"}
+}
+
+// GetPrivateAPINamespaces returns the privileged JSON-RPC namespaces, which should only be
+// served by the authenticated server.
+func GetPrivateAPINamespaces() []string {
+	return []string{"personal", "miner", "debug"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -281,6 +360,10 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		API:                      GetDefaultAPINamespaces(),
 		Address:                  DefaultJSONRPCAddress,
 		WsAddress:                DefaultJSONRPCWsAddress,
+		AuthEnable:               false,
+		AuthAddress:              DefaultJSONRPCAuthAddress,
+		AuthAPI:                  GetPrivateAPINamespaces(),
+		AuthJWTSecret:            "",
 		GasCap:                   DefaultGasCap,
 		EVMTimeout:               DefaultEVMTimeout,
 		TxFeeCap:                 DefaultTxFeeCap,
@@ -290,10 +373,26 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
+		WsOrigins:                []string{},
+		WsReadTimeout:            DefaultWsReadTimeout,
+		WsWriteTimeout:           DefaultWsWriteTimeout,
+		WsMaxMessageSize:         DefaultWsMaxMessageSize,
+		RateLimitPerSecond:       0,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		RateLimitAPIKeys:         []string{},
+		RateLimitTrustedProxies:  []string{},
+		MethodWeights:            GetDefaultMethodWeights(),
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		BatchConcurrency:         DefaultBatchConcurrency,
+		MaxConcurrentHeavyCalls:  0,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            true,
 		AllowIndexerGap:          true,
+		IndexerBackend:           IndexerBackendKV,
+		IndexerRetainBlocks:      0,
+		IndexerFullReceipts:      false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		ReturnDataLimit:          DefaultReturnDataLimit,
@@ -306,6 +405,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("cannot enable JSON-RPC without defining any API namespace")
 	}
 
+	if c.AuthEnable && len(c.AuthAPI) == 0 {
+		return errors.New("cannot enable authenticated JSON-RPC without defining any API namespace")
+	}
+
 	if c.FilterCap < 0 {
 		return errors.New("JSON-RPC filter-cap cannot be negative")
 	}
@@ -338,6 +441,63 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.WsReadTimeout < 0 {
+		return errors.New("JSON-RPC websocket read timeout duration cannot be negative")
+	}
+
+	if c.WsWriteTimeout < 0 {
+		return errors.New("JSON-RPC websocket write timeout duration cannot be negative")
+	}
+
+	if c.WsMaxMessageSize < 0 {
+		return errors.New("JSON-RPC websocket max message size cannot be negative")
+	}
+
+	if c.RateLimitPerSecond < 0 {
+		return errors.New("JSON-RPC rate limit per second cannot be negative")
+	}
+
+	if _, err := ParseTrustedProxies(c.RateLimitTrustedProxies); err != nil {
+		return err
+	}
+
+	weights, err := ParseMethodWeights(c.MethodWeights)
+	if err != nil {
+		return err
+	}
+
+	if c.RateLimitPerSecond > 0 {
+		for method, weight := range weights {
+			if weight > c.RateLimitBurst {
+				return fmt.Errorf("JSON-RPC weight of method %s cannot be greater than the rate limit burst %d", method, c.RateLimitBurst)
+			}
+		}
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	if c.BatchConcurrency < 0 {
+		return errors.New("JSON-RPC batch concurrency cannot be negative")
+	}
+
+	if c.MaxConcurrentHeavyCalls < 0 {
+		return errors.New("JSON-RPC max concurrent heavy calls cannot be negative")
+	}
+
+	if c.IndexerRetainBlocks < 0 {
+		return errors.New("JSON-RPC indexer retain blocks cannot be negative")
+	}
+
+	if c.IndexerBackend != IndexerBackendKV && c.IndexerBackend != IndexerBackendSQL {
+		return fmt.Errorf("JSON-RPC indexer backend must be %s or %s, got %s", IndexerBackendKV, IndexerBackendSQL, c.IndexerBackend)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -416,6 +576,10 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			API:                      v.GetStringSlice("json-rpc.api"),
 			Address:                  v.GetString("json-rpc.address"),
 			WsAddress:                v.GetString("json-rpc.ws-address"),
+			AuthEnable:               v.GetBool("json-rpc.auth-enable"),
+			AuthAddress:              v.GetString("json-rpc.auth-address"),
+			AuthAPI:                  v.GetStringSlice("json-rpc.auth-api"),
+			AuthJWTSecret:            v.GetString("json-rpc.auth-jwt-secret"),
 			GasCap:                   v.GetUint64("json-rpc.gas-cap"),
 			FilterCap:                v.GetInt32("json-rpc.filter-cap"),
 			FeeHistoryCap:            v.GetInt32("json-rpc.feehistory-cap"),
@@ -425,9 +589,25 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
 			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
 			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
+			WsOrigins:                v.GetStringSlice("json-rpc.ws-origins"),
+			WsReadTimeout:            v.GetDuration("json-rpc.ws-read-timeout"),
+			WsWriteTimeout:           v.GetDuration("json-rpc.ws-write-timeout"),
+			WsMaxMessageSize:         v.GetInt64("json-rpc.ws-max-message-size"),
+			RateLimitPerSecond:       v.GetFloat64("json-rpc.rate-limit-per-second"),
+			RateLimitBurst:           v.GetInt("json-rpc.rate-limit-burst"),
+			RateLimitAPIKeys:         v.GetStringSlice("json-rpc.rate-limit-api-keys"),
+			RateLimitTrustedProxies:  v.GetStringSlice("json-rpc.rate-limit-trusted-proxies"),
+			MethodWeights:            v.GetStringSlice("json-rpc.method-weights"),
+			BatchRequestLimit:        v.GetInt("json-rpc.batch-request-limit"),
+			BatchResponseMaxSize:     v.GetInt("json-rpc.batch-response-max-size"),
+			BatchConcurrency:         v.GetInt("json-rpc.batch-concurrency"),
+			MaxConcurrentHeavyCalls:  v.GetInt("json-rpc.max-concurrent-heavy-calls"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			AllowIndexerGap:          v.GetBool("json-rpc.allow-indexer-gap"),
+			IndexerBackend:           v.GetString("json-rpc.indexer-backend"),
+			IndexerRetainBlocks:      v.GetInt64("json-rpc.indexer-retain-blocks"),
+			IndexerFullReceipts:      v.GetBool("json-rpc.indexer-full-receipts"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			ReturnDataLimit:          v.GetInt64("json-rpc.return-data-limit"),
@@ -11,4 +11,45 @@ func TestDefaultConfig(t *testing.T) {
 	require.True(t, cfg.JSONRPC.Enable)
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
+	// the read-only requests of the batches are served concurrently
+	require.Greater(t, cfg.JSONRPC.BatchConcurrency, 1)
+}
+
+func TestParseMethodWeights(t *testing.T) {
+	weights, err := ParseMethodWeights(GetDefaultMethodWeights())
+	require.NoError(t, err)
+	require.Equal(t, 10, weights["eth_getLogs"])
+
+	weights, err = ParseMethodWeights([]string{" eth_call = 2 "})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"eth_call": 2}, weights)
+
+	for _, entry := range []string{"eth_call", "=2", "eth_call=0", "eth_call=a"} {
+		_, err = ParseMethodWeights([]string{entry})
+		require.Error(t, err, entry)
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{" 10.0.0.1 ", "192.168.0.0/16", "::1"})
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+	require.Equal(t, "10.0.0.1/32", proxies[0].String())
+	require.Equal(t, "192.168.0.0/16", proxies[1].String())
+	require.Equal(t, "::1/128", proxies[2].String())
+
+	for _, entry := range []string{"", "10.0.0", "10.0.0.0/33", "localhost"} {
+		_, err = ParseTrustedProxies([]string{entry})
+		require.Error(t, err, entry)
+	}
+}
+
+func TestValidateMethodWeights(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	cfg.RateLimitPerSecond = 10
+	cfg.RateLimitBurst = 50
+	require.Error(t, cfg.Validate())
+
+	cfg.RateLimitBurst = 100
+	require.NoError(t, cfg.Validate())
 }
@@ -0,0 +1,85 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// DefaultMethodWeight is the cost of the JSON-RPC methods without a configured weight,
+// the methods with a greater weight are considered heavy.
+const DefaultMethodWeight = 1
+
+// GetDefaultMethodWeights returns the default costs of the expensive JSON-RPC methods,
+// in the method=weight format.
+func GetDefaultMethodWeights() []string {
+	return []string{
+		"eth_getLogs=10",
+		"debug_traceTransaction=20",
+		"debug_traceCall=20",
+		"debug_traceBlockByNumber=50",
+		"debug_traceBlockByHash=50",
+		"trace_transaction=20",
+		"trace_call=20",
+		"trace_block=50",
+		"trace_replayBlockTransactions=50",
+		"trace_filter=100",
+	}
+}
+
+// ParseMethodWeights parses the method=weight entries of the method weights.
+func ParseMethodWeights(entries []string) (map[string]int, error) {
+	weights := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		method, value, ok := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method weight %q, expected method=weight", entry)
+		}
+
+		weight, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid weight of method %s: %q must be a positive integer", method, value)
+		}
+		weights[method] = weight
+	}
+	return weights, nil
+}
+
+// ParseTrustedProxies parses the IP or CIDR entries of the trusted proxies, an IP is a network of a single address.
+func ParseTrustedProxies(entries []string) ([]*net.IPNet, error) {
+	proxies := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q, expected an IP or a CIDR", entry)
+		}
+		proxies = append(proxies, ipNet)
+	}
+	return proxies, nil
+}
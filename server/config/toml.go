@@ -55,10 +55,26 @@ ws-address = "{{ .JSONRPC.WsAddress }}"
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
+# AuthEnable defines if the JWT authenticated EVM RPC HTTP server should be enabled, it serves the
+# privileged namespaces without exposing them on the public address.
+auth-enable = {{ .JSONRPC.AuthEnable }}
+
+# AuthAddress defines the JWT authenticated EVM RPC HTTP server address to bind to.
+auth-address = "{{ .JSONRPC.AuthAddress }}"
+
+# AuthAPI defines a list of JSON-RPC namespaces served by the JWT authenticated server.
+auth-api = "{{range $index, $elmt := .JSONRPC.AuthAPI}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# AuthJWTSecret is the path of the hex encoded 32 bytes secret of the HS256 tokens, the requests must
+# set a token with a recent issued-at claim in the "Authorization: Bearer" header. The secret is
+# generated on the first start if the file doesn't exist. Default: <home>/config/jwt.hex.
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
+# It also caps the total gas of the mempool transactions (at most 256) applied by the "pending" block queries.
 gas-cap = {{ .JSONRPC.GasCap }}
 
-# EVMTimeout is the global timeout for eth_call. Default: 5s.
+# EVMTimeout is the global timeout for eth_call and the "pending" block queries. Default: 5s.
 evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
@@ -82,6 +98,52 @@ http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
 http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 
+# WsOrigins defines the origins allowed to open websocket connections, "*" allows all the origins.
+# Requests without an Origin header are always allowed, an empty list only allows the same origin as the host.
+ws-origins = [{{range $index, $elmt := .JSONRPC.WsOrigins}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# WsReadTimeout is the max duration a websocket connection waits for the next message or pong (0=infinite),
+# the idle connections are pinged within it.
+ws-read-timeout = "{{ .JSONRPC.WsReadTimeout }}"
+
+# WsWriteTimeout is the write timeout of the websocket messages (0=infinite).
+ws-write-timeout = "{{ .JSONRPC.WsWriteTimeout }}"
+
+# WsMaxMessageSize is the max size in bytes of a message read from a websocket connection (0=unlimited).
+ws-max-message-size = {{ .JSONRPC.WsMaxMessageSize }}
+
+# RateLimitPerSecond is the number of request tokens refilled per second for each client IP or API key,
+# shared by the HTTP and websocket servers, 0 disables the rate limit. Each method spends its weight in tokens.
+rate-limit-per-second = {{ .JSONRPC.RateLimitPerSecond }}
+
+# RateLimitBurst is the max number of request tokens a client can spend at once, the batches costing more
+# are rejected with an invalid request error.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# RateLimitAPIKeys defines the API keys, sent in the X-API-Key header, rate limited separately from the client IP.
+rate-limit-api-keys = [{{range $index, $elmt := .JSONRPC.RateLimitAPIKeys}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimitTrustedProxies defines the IPs or CIDRs of the reverse proxies in front of the node. The client IP of
+# their requests is read from the X-Forwarded-For or X-Real-IP headers, the other requests use the remote address.
+rate-limit-trusted-proxies = [{{range $index, $elmt := .JSONRPC.RateLimitTrustedProxies}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# MethodWeights defines the request tokens spent by the methods in the method=weight format, the other
+# methods spend 1 token. The methods with a weight greater than 1 are heavy.
+method-weights = [{{range $index, $elmt := .JSONRPC.MethodWeights}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# BatchRequestLimit is the max number of requests in a batch (0=unlimited).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# BatchResponseMaxSize is the max size in bytes of the responses of a batch (0=unlimited).
+batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
+
+# BatchConcurrency is the number of read-only requests of the batches served at the same time, shared by
+# all the batches, one per CPU by default. The batches are served sequentially if it's lower than 2.
+batch-concurrency = {{ .JSONRPC.BatchConcurrency }}
+
+# MaxConcurrentHeavyCalls is the max number of requests with heavy methods served at the same time (0=unlimited).
+max-concurrent-heavy-calls = {{ .JSONRPC.MaxConcurrentHeavyCalls }}
+
 # AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 # the node's RPC when the global parameter is disabled.
 allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
@@ -96,6 +158,20 @@ enable-indexer = {{ .JSONRPC.EnableIndexer }}
 # AllowIndexerGap allow block gap for the custom transaction indexer for the EVM (ethereum transactions).
 allow-indexer-gap = {{ .JSONRPC.AllowIndexerGap }}
 
+# IndexerBackend defines the storage of the custom transaction indexer, valid values are:
+# - kv: a KV db using the same db backend as the main app.
+# - sql: an embedded sqlite database with relational tables of blocks, txs, receipts and logs,
+#   it can be queried with the query-eth-index command.
+indexer-backend = "{{ .JSONRPC.IndexerBackend }}"
+
+# IndexerRetainBlocks defines the number of recent blocks kept by the custom transaction indexer,
+# the older blocks are pruned in the background. 0 means keep all the blocks.
+indexer-retain-blocks = {{ .JSONRPC.IndexerRetainBlocks }}
+
+# IndexerFullReceipts enables storing the full receipts of the ethereum transactions in the kv indexer,
+# so the receipts are served after the block results are pruned. The sql indexer always stores them.
+indexer-full-receipts = {{ .JSONRPC.IndexerFullReceipts }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -54,6 +54,10 @@ const (
 	JSONRPCAPI                 = "json-rpc.api"
 	JSONRPCAddress             = "json-rpc.address"
 	JSONWsAddress              = "json-rpc.ws-address"
+	JSONRPCAuthEnable          = "json-rpc.auth-enable"
+	JSONRPCAuthAddress         = "json-rpc.auth-address"
+	JSONRPCAuthAPI             = "json-rpc.auth-api"
+	JSONRPCAuthJWTSecret       = "json-rpc.auth-jwt-secret"
 	JSONRPCGasCap              = "json-rpc.gas-cap"
 	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
 	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
@@ -63,10 +67,26 @@ const (
 	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
 	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
+	JSONRPCWsOrigins           = "json-rpc.ws-origins"
+	JSONRPCWsReadTimeout       = "json-rpc.ws-read-timeout"
+	JSONRPCWsWriteTimeout      = "json-rpc.ws-write-timeout"
+	JSONRPCWsMaxMessageSize    = "json-rpc.ws-max-message-size"
+	JSONRPCRateLimitPerSecond  = "json-rpc.rate-limit-per-second"
+	JSONRPCRateLimitBurst      = "json-rpc.rate-limit-burst"
+	JSONRPCRateLimitAPIKeys    = "json-rpc.rate-limit-api-keys"
+	JSONRPCRateLimitProxies    = "json-rpc.rate-limit-trusted-proxies"
+	JSONRPCMethodWeights       = "json-rpc.method-weights"
+	JSONRPCBatchRequestLimit   = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMax    = "json-rpc.batch-response-max-size"
+	JSONRPCBatchConcurrency    = "json-rpc.batch-concurrency"
+	JSONRPCMaxConcurrentHeavy  = "json-rpc.max-concurrent-heavy-calls"
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
 	JSONRPCAllowIndexerGap     = "json-rpc.allow-indexer-gap"
+	JSONRPCIndexerBackend      = "json-rpc.indexer-backend"
+	JSONRPCIndexerRetainBlocks = "json-rpc.indexer-retain-blocks"
+	JSONRPCIndexerFullReceipts = "json-rpc.indexer-full-receipts"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -16,6 +16,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -25,7 +26,10 @@ import (
 	cmtstore "github.com/cometbft/cometbft/store"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
-	"github.com/Helios-Chain-Labs/ethermint/indexer"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Helios-Chain-Labs/ethermint/server/config"
+	srvflags "github.com/Helios-Chain-Labs/ethermint/server/flags"
 )
 
 func NewIndexTxCmd() *cobra.Command {
@@ -55,12 +59,17 @@ func NewIndexTxCmd() *cobra.Command {
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			evmCfg, err := config.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			idxer, err := NewEVMTxIndexer(
+				evmCfg.JSONRPC, home, server.GetAppDBBackend(serverCtx.Viper), logger.With("module", "evmindex"), clientCtx,
+			)
 			if err != nil {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local tendermint db, because the local rpc won't be available.
 			cmtdb, err := cmtnode.DefaultDBProvider(&cmtnode.DBContext{ID: "blockstore", Config: cfg})
@@ -86,7 +95,7 @@ func NewIndexTxCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				if err := idxer.IndexBlock(blk, resBlk.TxResults, resBlk.Events); err != nil {
 					return err
 				}
 				return nil
@@ -128,5 +137,65 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, config.IndexerBackendKV, "Storage of the custom tx indexer, kv or sql")
+	cmd.Flags().Bool(srvflags.JSONRPCIndexerFullReceipts, false, "Store the full receipts in the custom kv tx indexer")
+	return cmd
+}
+
+func NewQueryIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-eth-index [sql]",
+		Short: "Query the sql eth tx indexer",
+		Long: `Run a read-only sql query against the sqlite database of the eth tx indexer, which is used when json-rpc.indexer-backend is "sql".
+		The tables are blocks, txs, receipts and logs, the hashes and addresses are stored as lower case hex strings, and the rows are printed as json lines.
+
+		Example:
+		query-eth-index "SELECT sender, COUNT(*) AS txs FROM txs GROUP BY sender ORDER BY txs DESC LIMIT 10"
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			db, err := OpenSQLIndexerDB(serverCtx.Config.RootDir, true)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			rows, err := db.QueryContext(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+
+			columns, err := rows.Columns()
+			if err != nil {
+				return err
+			}
+			values := make([]interface{}, len(columns))
+			dest := make([]interface{}, len(columns))
+			for i := range values {
+				dest[i] = &values[i]
+			}
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			for rows.Next() {
+				if err := rows.Scan(dest...); err != nil {
+					return err
+				}
+				row := make(map[string]interface{}, len(columns))
+				for i, column := range columns {
+					if bz, ok := values[i].([]byte); ok {
+						row[column] = hexutil.Bytes(bz)
+					} else {
+						row[column] = values[i]
+					}
+				}
+				if err := enc.Encode(row); err != nil {
+					return err
+				}
+			}
+			return rows.Err()
+		},
+	}
 	return cmd
 }
@@ -17,6 +17,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
 
 	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
 )
@@ -36,24 +38,33 @@ const (
 	// https://github.com/cometbft/cometbft/blob/v0.37.4/rpc/core/env.go#L193
 	NotFoundErr          = "is not available"
 	ErrorBackoffDuration = 1 * time.Second
+
+	// PruneInterval is the minimal number of blocks between two prunings of the indexer
+	PruneInterval = 100
 )
 
+// indexerLagGauge tracks the number of blocks the indexer is behind the chain head.
+var indexerLagGauge = ethmetrics.NewRegisteredGauge("ethermint/indexer/lag", nil)
+
 // EVMIndexerService indexes transactions for json-rpc service.
 type EVMIndexerService struct {
 	service.BaseService
 
-	txIdxr   ethermint.EVMTxIndexer
-	client   rpcclient.Client
-	allowGap bool
+	txIdxr       ethermint.EVMTxIndexer
+	client       rpcclient.Client
+	allowGap     bool
+	retainBlocks int64
 }
 
-// NewEVMIndexerService returns a new service instance.
+// NewEVMIndexerService returns a new service instance, the blocks older than the last retainBlocks ones are pruned
+// from the indexer if retainBlocks is positive.
 func NewEVMIndexerService(
 	txIdxr ethermint.EVMTxIndexer,
 	client rpcclient.Client,
 	allowGap bool,
+	retainBlocks int64,
 ) *EVMIndexerService {
-	is := &EVMIndexerService{txIdxr: txIdxr, client: client, allowGap: allowGap}
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client, allowGap: allowGap, retainBlocks: retainBlocks}
 	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
 	return is
 }
@@ -102,19 +113,29 @@ func (eis *EVMIndexerService) OnStart() error {
 	}
 	if lastBlock == -1 {
 		lastBlock = latestBlock
-	} else if lastBlock < status.SyncInfo.EarliestBlockHeight {
+	} else if earliest := status.SyncInfo.EarliestBlockHeight; lastBlock < earliest-1 {
 		if !eis.allowGap {
-			panic("Block gap detected, please recover the missing data")
+			return fmt.Errorf("block gap detected from %d to %d, please recover the missing data", lastBlock+1, earliest-1)
+		}
+		eis.Logger.Error("block gap detected, the blocks are not available", "from", lastBlock+1, "to", earliest-1)
+		if err := eis.txIdxr.RecordGap(lastBlock+1, earliest-1); err != nil {
+			return err
 		}
 		// to avoid infinite failed to fetch block error when lastBlock is smaller than earliest
-		lastBlock = status.SyncInfo.EarliestBlockHeight
+		lastBlock = earliest - 1
 	}
 	// to avoid height must be greater than 0 error
 	if lastBlock <= 0 {
 		lastBlock = 1
 	}
 
+	lastBlockChan := make(chan int64, 1)
+	if eis.retainBlocks > 0 {
+		go eis.pruneBlocks(lastBlockChan)
+	}
+
 	for {
+		indexerLagGauge.Update(max(latestBlock-lastBlock, 0))
 		if latestBlock <= lastBlock {
 			// nothing to index. wait for signal of new block
 
@@ -133,6 +154,7 @@ func (eis *EVMIndexerService) OnStart() error {
 			block, err = eis.client.Block(ctx, &i)
 			if err != nil {
 				if eis.allowGap && strings.Contains(err.Error(), NotFoundErr) {
+					eis.recordGap(i)
 					continue
 				}
 				eis.Logger.Error("failed to fetch block", "height", i, "err", err)
@@ -141,18 +163,49 @@ func (eis *EVMIndexerService) OnStart() error {
 			blockResult, err = eis.client.BlockResults(ctx, &i)
 			if err != nil {
 				if eis.allowGap && strings.Contains(err.Error(), NotFoundErr) {
+					eis.recordGap(i)
 					continue
 				}
 				eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
 				break
 			}
-			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults, blockResult.FinalizeBlockEvents); err != nil {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
 			}
 			lastBlock = blockResult.Height
+			indexerLagGauge.Update(max(latestBlock-lastBlock, 0))
+		}
+		// notify the pruning routine, skip if it's busy
+		select {
+		case lastBlockChan <- lastBlock:
+		default:
 		}
 		if err != nil {
 			time.Sleep(ErrorBackoffDuration)
 		}
 	}
 }
+
+// recordGap records the skipped block in the indexer, so it's reported to the clients
+func (eis *EVMIndexerService) recordGap(height int64) {
+	if err := eis.txIdxr.RecordGap(height, height); err != nil {
+		eis.Logger.Error("failed to record block gap", "height", height, "err", err)
+	}
+}
+
+// pruneBlocks prunes the indexer in the background to keep the last retainBlocks blocks
+func (eis *EVMIndexerService) pruneBlocks(lastBlockChan <-chan int64) {
+	var prunedHeight int64
+	for lastBlock := range lastBlockChan {
+		retainHeight := lastBlock - eis.retainBlocks + 1
+		if retainHeight-prunedHeight < PruneInterval {
+			continue
+		}
+		if err := eis.txIdxr.PruneBlocks(retainHeight); err != nil {
+			eis.Logger.Error("failed to prune indexer", "retainHeight", retainHeight, "err", err)
+			continue
+		}
+		eis.Logger.Info("pruned indexer", "retainHeight", retainHeight)
+		prunedHeight = retainHeight
+	}
+}
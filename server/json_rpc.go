@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -33,6 +34,10 @@ import (
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/Helios-Chain-Labs/ethermint/app/ante"
 	"github.com/Helios-Chain-Labs/ethermint/rpc"
+	"github.com/Helios-Chain-Labs/ethermint/rpc/auth"
+	"github.com/Helios-Chain-Labs/ethermint/rpc/batch"
+	rpcmetrics "github.com/Helios-Chain-Labs/ethermint/rpc/metrics"
+	"github.com/Helios-Chain-Labs/ethermint/rpc/ratelimit"
 	"github.com/Helios-Chain-Labs/ethermint/rpc/stream"
 	"github.com/Helios-Chain-Labs/ethermint/server/config"
 	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
@@ -84,25 +89,40 @@ func StartJSONRPC(srvCtx *server.Context,
 	ethlog.SetDefault(ethlog.NewLogger(handler))
 
 	rpcServer := ethrpc.NewServer()
+	rpcServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
 
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
 	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, rpcStream, allowUnprotectedTxs, indexer, rpcAPIArr)
 
-	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			srvCtx.Logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
+	if err := registerAPIs(srvCtx, rpcServer, apis); err != nil {
+		return nil, nil, err
+	}
+
+	// the limiter is shared with the websocket server
+	var limiter *ratelimit.Limiter
+	if ratelimit.Enabled(config.JSONRPC) {
+		limiter, err = ratelimit.NewLimiter(config.JSONRPC, srvCtx.Logger)
+		if err != nil {
 			return nil, nil, err
 		}
 	}
 
+	// the heavy calls are limited behind the batch handler, so each request of a batch takes a slot
+	rpcHandler := rpcmetrics.NewHandler(rpcServer)
+	if limiter != nil {
+		rpcHandler = ratelimit.NewHeavyHandler(rpcHandler, limiter)
+	}
+	if batch.Enabled(config.JSONRPC) {
+		rpcHandler = batch.NewHandler(rpcHandler, config.JSONRPC)
+	}
+	if limiter != nil {
+		rpcHandler = ratelimit.NewHandler(rpcHandler, limiter)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -139,11 +159,96 @@ func StartJSONRPC(srvCtx *server.Context,
 
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, srvCtx.Logger, rpcStream, config)
+	wsSrv, err := rpc.NewWebsocketsServer(clientCtx, srvCtx.Logger, rpcServer, rpcStream, limiter, config)
+	if err != nil {
+		return nil, nil, err
+	}
 	wsSrv.Start()
+
+	if config.JSONRPC.AuthEnable {
+		authSrv, err := startAuthJSONRPC(srvCtx, clientCtx, g, config, rpcStream, indexer)
+		if err != nil {
+			return nil, nil, err
+		}
+		httpSrv.RegisterOnShutdown(func() {
+			_ = authSrv.Close()
+		})
+	}
 	return httpSrv, httpSrvDone, nil
 }
 
+// startAuthJSONRPC starts the JSON-RPC server of the privileged namespaces, the requests are
+// authenticated with a JWT signed with the secret shared with the operator.
+func startAuthJSONRPC(
+	srvCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	config *config.Config,
+	rpcStream *stream.RPCStream,
+	indexer ethermint.EVMTxIndexer,
+) (*http.Server, error) {
+	secretPath := config.JSONRPC.AuthJWTSecret
+	if secretPath == "" {
+		secretPath = filepath.Join("config", "jwt.hex")
+	}
+	if !filepath.IsAbs(secretPath) {
+		secretPath = filepath.Join(srvCtx.Config.RootDir, secretPath)
+	}
+	secret, err := auth.ObtainJWTSecret(secretPath, srvCtx.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain JWT secret: %w", err)
+	}
+
+	authServer := ethrpc.NewServer()
+	authServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, rpcStream, config.JSONRPC.AllowUnprotectedTxs, indexer, config.JSONRPC.AuthAPI)
+	if err := registerAPIs(srvCtx, authServer, apis); err != nil {
+		return nil, err
+	}
+
+	r := mux.NewRouter()
+	r.Handle("/", auth.NewJWTHandler(secret, rpcmetrics.NewHandler(authServer))).Methods("POST")
+
+	authSrv := &http.Server{
+		Addr:              config.JSONRPC.AuthAddress,
+		Handler:           r,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(authSrv.Addr, config)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Go(func() error {
+		srvCtx.Logger.Info("Starting authenticated JSON-RPC server", "address", config.JSONRPC.AuthAddress)
+		if err := authSrv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			srvCtx.Logger.Error("failed to start authenticated JSON-RPC server", "error", err.Error())
+			return err
+		}
+		return nil
+	})
+	return authSrv, nil
+}
+
+// registerAPIs registers the services of the APIs in their JSON-RPC namespaces.
+func registerAPIs(srvCtx *server.Context, rpcServer *ethrpc.Server, apis []ethrpc.API) error {
+	for _, api := range apis {
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			srvCtx.Logger.Error(
+				"failed to register service in JSON RPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return err
+		}
+	}
+	return nil
+}
+
 type WrappedSdkLogger struct {
 	logger sdklog.Logger
 }
@@ -17,6 +17,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net"
@@ -52,6 +53,7 @@ import (
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -205,18 +207,38 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().Bool(srvflags.JSONRPCAuthEnable, false, "Define if the JWT authenticated JSON-RPC server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCAuthAddress, config.DefaultJSONRPCAuthAddress, "the JWT authenticated JSON-RPC server address to listen on")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPI, config.GetPrivateAPINamespaces(), "JSON-RPC namespaces served by the JWT authenticated server")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "Path of the JWT secret file, generated if missing (default <home>/config/jwt.hex)")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aphoton (0=infinite)")     //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 photon)") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCWsOrigins, []string{}, "Origins allowed to open json-rpc ws connections, \"*\" allows all")
+	cmd.Flags().Duration(srvflags.JSONRPCWsReadTimeout, config.DefaultWsReadTimeout, "Sets a read timeout for json-rpc ws connections (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCWsWriteTimeout, config.DefaultWsWriteTimeout, "Sets a write timeout for json-rpc ws messages (0=infinite)")
+	cmd.Flags().Int64(srvflags.JSONRPCWsMaxMessageSize, config.DefaultWsMaxMessageSize, "Sets the max bytes of a json-rpc ws message (0=unlimited)")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitPerSecond, 0, "Sets the request tokens refilled per second for each json-rpc client (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the max request tokens a json-rpc client can spend at once")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitAPIKeys, []string{}, "API keys rate limited separately from the json-rpc client IP")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitProxies, []string{}, "IPs or CIDRs of the reverse proxies the json-rpc client IP is read from")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodWeights, config.GetDefaultMethodWeights(), "Tokens spent by json-rpc methods (method=weight)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the max requests in a json-rpc batch (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMax, config.DefaultBatchResponseMaxSize, "Sets the max bytes of json-rpc batch responses (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchConcurrency, config.DefaultBatchConcurrency, "Sets the read-only json-rpc batch requests served at once")
+	cmd.Flags().Int(srvflags.JSONRPCMaxConcurrentHeavy, 0, "Sets the max number of heavy json-rpc requests served at once (0=unlimited)")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, true, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowIndexerGap, true, "Allow block gap for the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, config.IndexerBackendKV, "Storage of the custom tx indexer for json-rpc, kv or sql")
+	cmd.Flags().Int64(srvflags.JSONRPCIndexerRetainBlocks, 0, "Number of recent blocks kept by the custom tx indexer for json-rpc, 0 keeps all")
+	cmd.Flags().Bool(srvflags.JSONRPCIndexerFullReceipts, false, "Store the full receipts in the custom kv tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown|firestore)") //nolint:lll
@@ -420,15 +442,15 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer ethermint.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := logger.With("indexer", "evm")
+		idxer, err = NewEVMTxIndexer(config.JSONRPC, home, server.GetAppDBBackend(svrCtx.Viper), idxLogger, clientCtx)
 		if err != nil {
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client), config.JSONRPC.AllowIndexerGap)
+		indexerService := NewEVMIndexerService(
+			idxer, clientCtx.Client.(rpcclient.Client), config.JSONRPC.AllowIndexerGap, config.JSONRPC.IndexerRetainBlocks,
+		)
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
 		g.Go(func() error {
@@ -505,6 +527,36 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenSQLIndexerDB opens the sqlite database of the custom eth indexer
+func OpenSQLIndexerDB(rootDir string, readOnly bool) (*sql.DB, error) {
+	return indexer.OpenSQLiteDB(filepath.Join(rootDir, "data", "evmindexer.sqlite"), readOnly)
+}
+
+// NewEVMTxIndexer opens the custom eth indexer of the configured backend
+func NewEVMTxIndexer(
+	cfg config.JSONRPCConfig,
+	rootDir string,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (ethermint.EVMTxIndexer, error) {
+	if cfg.IndexerBackend == config.IndexerBackendSQL {
+		db, err := OpenSQLIndexerDB(rootDir, false)
+		if err != nil {
+			return nil, err
+		}
+		return indexer.NewSQLIndexer(db, logger, clientCtx)
+	}
+
+	idxDB, err := OpenIndexerDB(rootDir, backendType)
+	if err != nil {
+		return nil, err
+	}
+	kvIndexer := indexer.NewKVIndexer(idxDB, logger, clientCtx)
+	kvIndexer.SetFullReceipts(cfg.IndexerFullReceipts)
+	return kvIndexer, nil
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
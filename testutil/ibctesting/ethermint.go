@@ -0,0 +1,63 @@
+package ibctesting
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+
+	"github.com/Helios-Chain-Labs/ethermint/app"
+	feemarkettypes "github.com/Helios-Chain-Labs/ethermint/x/feemarket/types"
+)
+
+var _ TestingApp = EthermintApp{}
+
+// EthermintApp implements the TestingApp interface on top of the EthermintApp
+type EthermintApp struct {
+	*app.EthermintApp
+}
+
+// SetupEthermintApp creates an EthermintApp for a test chain, the base fee is disabled so the relayer txs don't
+// pay fees.
+func SetupEthermintApp() (TestingApp, map[string]json.RawMessage) {
+	ethermintApp := app.NewEthermintApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{
+		flags.FlagHome:            app.DefaultNodeHome,
+		server.FlagInvCheckPeriod: 5,
+	})
+	genesisState := ethermintApp.DefaultGenesis()
+
+	var feemarketGenesis feemarkettypes.GenesisState
+	ethermintApp.AppCodec().MustUnmarshalJSON(genesisState[feemarkettypes.ModuleName], &feemarketGenesis)
+	feemarketGenesis.Params.NoBaseFee = true
+	genesisState[feemarkettypes.ModuleName] = ethermintApp.AppCodec().MustMarshalJSON(&feemarketGenesis)
+
+	return EthermintApp{ethermintApp}, genesisState
+}
+
+func (a EthermintApp) GetBaseApp() *baseapp.BaseApp {
+	return a.BaseApp
+}
+
+func (a EthermintApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return a.StakingKeeper
+}
+
+func (a EthermintApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return a.IBCKeeper
+}
+
+func (a EthermintApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return a.ScopedIBCKeeper
+}
+
+func (a EthermintApp) GetTxConfig() client.TxConfig {
+	return a.TxConfig()
+}
@@ -19,16 +19,57 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
-	IndexBlock(*tmtypes.Block, []*abci.ExecTxResult) error
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+	// IndexBlock indexes the eth txs of the block, the finalize block events carry the base fee of the block.
+	IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxResult, finalizeEvents []abci.Event) error
 
 	// GetByTxHash returns nil if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// FilterLogHeights returns the candidate heights within [from, to] for the log filter criteria.
+	FilterLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
+
+	// PruneBlocks removes the indexed txs of the blocks below retainHeight.
+	PruneBlocks(retainHeight int64) error
+	// RecordGap records the block range [from, to] which is not indexed.
+	RecordGap(from, to int64) error
+	// Gaps returns the recorded block ranges which are not indexed, in ascending order.
+	Gaps() ([]IndexerGap, error)
+}
+
+// IndexerGap is a block range skipped by the eth tx indexer, because the blocks were not available on the node.
+type IndexerGap struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+// EthLogs returns the logs of the receipt of the indexed eth tx.
+func (r *TxReceipt) EthLogs(res *TxResult, txHash common.Hash) []*ethtypes.Log {
+	logs := make([]*ethtypes.Log, len(r.Logs))
+	for i, log := range r.Logs {
+		topics := make([]common.Hash, len(log.Topics))
+		for j, topic := range log.Topics {
+			topics[j] = common.BytesToHash(topic)
+		}
+		logs[i] = &ethtypes.Log{
+			Address:     common.BytesToAddress(log.Address),
+			Topics:      topics,
+			Data:        log.Data,
+			BlockNumber: uint64(res.Height),
+			TxHash:      txHash,
+			TxIndex:     uint(res.EthTxIndex),
+			BlockHash:   common.BytesToHash(r.BlockHash),
+			Index:       uint(log.Index),
+		}
+	}
+	return logs
 }
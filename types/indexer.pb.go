@@ -42,6 +42,9 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch transaction.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// receipt is the rest of the eth tx receipt, it's only stored if the indexer
+	// is configured to store the full receipts.
+	Receipt *TxReceipt `protobuf:"bytes,8,opt,name=receipt,proto3" json:"receipt,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -77,33 +80,161 @@ func (m *TxResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TxResult proto.InternalMessageInfo
 
+// TxReceipt is the part of the eth tx receipt which is not in the TxResult,
+// so the receipt can be served without the block results.
+type TxReceipt struct {
+	// block_hash is the hash of the block containing the tx
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// tx_type is the type of the eth tx
+	TxType uint32 `protobuf:"varint,2,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// from is the sender address of the tx
+	From []byte `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the recipient address of the tx, empty for contract creation
+	To []byte `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// contract_address is the address of the created contract, empty if the tx
+	// is not a contract creation.
+	ContractAddress []byte `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// gas_limit of the tx
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// effective_gas_price is the decimal gas price paid by the tx, empty if the
+	// base fee is unknown for a dynamic fee tx.
+	EffectiveGasPrice string `protobuf:"bytes,7,opt,name=effective_gas_price,json=effectiveGasPrice,proto3" json:"effective_gas_price,omitempty"`
+	// blob_gas_used by a blob tx
+	BlobGasUsed uint64 `protobuf:"varint,8,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	// block_cumulative_gas_used is the gas used in the block up to and including
+	// the tx.
+	BlockCumulativeGasUsed uint64 `protobuf:"varint,9,opt,name=block_cumulative_gas_used,json=blockCumulativeGasUsed,proto3" json:"block_cumulative_gas_used,omitempty"`
+	// bloom is the bloom filter of the tx logs
+	Bloom []byte `protobuf:"bytes,10,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	// logs emitted by the tx
+	Logs []*TxLog `protobuf:"bytes,11,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *TxReceipt) Reset()         { *m = TxReceipt{} }
+func (m *TxReceipt) String() string { return proto.CompactTextString(m) }
+func (*TxReceipt) ProtoMessage()    {}
+func (*TxReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1197e10a8be8ed28, []int{1}
+}
+func (m *TxReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReceipt.Merge(m, src)
+}
+func (m *TxReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReceipt proto.InternalMessageInfo
+
+// TxLog is a log emitted by an eth tx, the block and tx fields are the ones of
+// the TxResult.
+type TxLog struct {
+	// address of the contract emitting the log
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// topics of the log
+	Topics [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	// data of the log
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// index of the log in the block
+	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *TxLog) Reset()         { *m = TxLog{} }
+func (m *TxLog) String() string { return proto.CompactTextString(m) }
+func (*TxLog) ProtoMessage()    {}
+func (*TxLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1197e10a8be8ed28, []int{2}
+}
+func (m *TxLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxLog.Merge(m, src)
+}
+func (m *TxLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxLog proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.types.v1.TxResult")
+	proto.RegisterType((*TxReceipt)(nil), "ethermint.types.v1.TxReceipt")
+	proto.RegisterType((*TxLog)(nil), "ethermint.types.v1.TxLog")
 }
 
 func init() { proto.RegisterFile("ethermint/types/v1/indexer.proto", fileDescriptor_1197e10a8be8ed28) }
 
 var fileDescriptor_1197e10a8be8ed28 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0x86, 0x73, 0xb6, 0x4d, 0xe3, 0xa1, 0x83, 0x51, 0x4a, 0x54, 0x88, 0x87, 0x53, 0xa6, 0x84,
-	0xe2, 0xd6, 0xd1, 0x45, 0x5c, 0x8f, 0xba, 0xb8, 0x84, 0xb4, 0xf9, 0xbc, 0x3b, 0xe8, 0xf5, 0x4a,
-	0xef, 0x4b, 0x88, 0xff, 0xc0, 0xd1, 0x9f, 0xe0, 0xcf, 0x71, 0xec, 0xe8, 0x28, 0x2d, 0xfe, 0x0f,
-	0xe9, 0x35, 0x44, 0x70, 0xfb, 0x5e, 0x9e, 0xe7, 0xe3, 0x85, 0x97, 0x32, 0x40, 0x09, 0x6b, 0xad,
-	0x96, 0x98, 0xe1, 0xeb, 0x0a, 0x6c, 0x56, 0x8f, 0x33, 0xb5, 0x2c, 0xa1, 0x81, 0x75, 0xba, 0x5a,
-	0x1b, 0x34, 0x61, 0xd8, 0x19, 0xa9, 0x33, 0xd2, 0x7a, 0x7c, 0x75, 0x21, 0x8c, 0x30, 0x0e, 0x67,
-	0xfb, 0xeb, 0x60, 0xde, 0xfe, 0x10, 0x1a, 0x4c, 0x1b, 0x0e, 0xb6, 0x5a, 0x60, 0x38, 0xa2, 0xbe,
-	0x04, 0x25, 0x24, 0x46, 0x84, 0x91, 0xa4, 0xc7, 0xdb, 0x14, 0x5e, 0xd2, 0x00, 0x9b, 0xdc, 0x55,
-	0x44, 0x47, 0x8c, 0x24, 0xa7, 0x7c, 0x88, 0xcd, 0xe3, 0x3e, 0x86, 0xd7, 0xf4, 0x58, 0x5b, 0xd1,
-	0xb2, 0x9e, 0x63, 0x81, 0xb6, 0xe2, 0x00, 0x19, 0x3d, 0x01, 0x94, 0x79, 0xf7, 0xdb, 0x67, 0x24,
-	0x19, 0x70, 0x0a, 0x28, 0xa7, 0xed, 0xfb, 0x88, 0xfa, 0x2f, 0x85, 0x5a, 0x40, 0x19, 0x0d, 0x18,
-	0x49, 0x02, 0xde, 0xa6, 0x7d, 0xa3, 0x28, 0x6c, 0x5e, 0x59, 0x28, 0x23, 0x9f, 0x91, 0xa4, 0xcf,
-	0x87, 0xa2, 0xb0, 0x4f, 0x16, 0xca, 0x30, 0xa5, 0xe7, 0xf3, 0x4a, 0x57, 0x8b, 0x02, 0x55, 0x0d,
-	0x79, 0x67, 0x0d, 0x9d, 0x75, 0xf6, 0x87, 0x1e, 0x0e, 0xfe, 0xa4, 0xff, 0xf6, 0x71, 0xe3, 0xdd,
-	0x4f, 0x3e, 0xb7, 0x31, 0xd9, 0x6c, 0x63, 0xf2, 0xbd, 0x8d, 0xc9, 0xfb, 0x2e, 0xf6, 0x36, 0xbb,
-	0xd8, 0xfb, 0xda, 0xc5, 0xde, 0x33, 0x13, 0x0a, 0x65, 0x35, 0x4b, 0xe7, 0x46, 0x67, 0x50, 0x6b,
-	0x63, 0xb3, 0x7f, 0xf3, 0xce, 0x7c, 0x37, 0xd5, 0xdd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1,
-	0x34, 0xa8, 0x0b, 0x78, 0x01, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcd, 0x6e, 0xd4, 0x30,
+	0x14, 0x85, 0x27, 0xf3, 0x9b, 0xb9, 0x93, 0x02, 0x35, 0x55, 0x49, 0x41, 0x0d, 0x51, 0x57, 0x41,
+	0xa2, 0x89, 0x5a, 0x16, 0x08, 0x76, 0x50, 0x89, 0x16, 0xa9, 0x0b, 0x64, 0x95, 0x0d, 0x9b, 0xc8,
+	0x49, 0x3c, 0x89, 0x45, 0x32, 0x8e, 0x62, 0x4f, 0x95, 0xbe, 0x01, 0xec, 0x78, 0x02, 0xc4, 0xe3,
+	0xb0, 0xec, 0x92, 0x25, 0x6a, 0x5f, 0x04, 0xd9, 0xf9, 0x19, 0x89, 0x76, 0xe7, 0x73, 0xcf, 0x89,
+	0x9d, 0xfb, 0x5d, 0x1b, 0x5c, 0x2a, 0x33, 0x5a, 0x15, 0x6c, 0x25, 0x03, 0x79, 0x55, 0x52, 0x11,
+	0x5c, 0x1e, 0x05, 0x6c, 0x95, 0xd0, 0x9a, 0x56, 0x7e, 0x59, 0x71, 0xc9, 0x11, 0xea, 0x13, 0xbe,
+	0x4e, 0xf8, 0x97, 0x47, 0x4f, 0x77, 0x52, 0x9e, 0x72, 0x6d, 0x07, 0x6a, 0xd5, 0x24, 0x0f, 0x7e,
+	0x0e, 0xc1, 0xbc, 0xa8, 0x31, 0x15, 0xeb, 0x5c, 0xa2, 0x5d, 0x98, 0x66, 0x94, 0xa5, 0x99, 0xb4,
+	0x0d, 0xd7, 0xf0, 0x46, 0xb8, 0x55, 0x68, 0x0f, 0x4c, 0x59, 0x87, 0xfa, 0x08, 0x7b, 0xe8, 0x1a,
+	0xde, 0x16, 0x9e, 0xc9, 0xfa, 0xa3, 0x92, 0xe8, 0x19, 0xcc, 0x0b, 0x91, 0xb6, 0xde, 0x48, 0x7b,
+	0x66, 0x21, 0xd2, 0xc6, 0x74, 0xc1, 0xa2, 0x32, 0x0b, 0xfb, 0x6f, 0xc7, 0xae, 0xe1, 0x4d, 0x30,
+	0x50, 0x99, 0x5d, 0xb4, 0x9f, 0xef, 0xc2, 0x74, 0x49, 0x58, 0x4e, 0x13, 0x7b, 0xe2, 0x1a, 0x9e,
+	0x89, 0x5b, 0xa5, 0x4e, 0x4c, 0x89, 0x08, 0xd7, 0x82, 0x26, 0xf6, 0xd4, 0x35, 0xbc, 0x31, 0x9e,
+	0xa5, 0x44, 0x7c, 0x16, 0x34, 0x41, 0x3e, 0x3c, 0x8e, 0xd7, 0xc5, 0x3a, 0x27, 0x92, 0x5d, 0xd2,
+	0xb0, 0x4f, 0xcd, 0x74, 0x6a, 0x7b, 0x63, 0x9d, 0xb6, 0xf9, 0xd7, 0x30, 0xab, 0x68, 0x4c, 0x59,
+	0x29, 0x6d, 0xd3, 0x35, 0xbc, 0xc5, 0xf1, 0xbe, 0x7f, 0x97, 0x8e, 0xaf, 0x18, 0xe8, 0x10, 0xee,
+	0xd2, 0x6f, 0xc7, 0xdf, 0x7e, 0x3d, 0x1f, 0x1c, 0x7c, 0x1f, 0xc1, 0xbc, 0x37, 0xd1, 0x3e, 0x40,
+	0x94, 0xf3, 0xf8, 0x6b, 0x98, 0x11, 0x91, 0x69, 0x4a, 0x16, 0x9e, 0xeb, 0xca, 0x19, 0x11, 0x19,
+	0x7a, 0x02, 0x33, 0x59, 0x87, 0x6a, 0xd3, 0x96, 0xd3, 0x54, 0xd6, 0x17, 0x57, 0x25, 0x45, 0x08,
+	0xc6, 0xcb, 0x8a, 0x17, 0x9a, 0x90, 0x85, 0xf5, 0x1a, 0x3d, 0x80, 0xa1, 0xe4, 0x9a, 0x89, 0x85,
+	0x87, 0x92, 0xa3, 0x17, 0xf0, 0x28, 0xe6, 0x2b, 0x59, 0x91, 0x58, 0x86, 0x24, 0x49, 0x2a, 0x2a,
+	0x84, 0xa6, 0x62, 0xe1, 0x87, 0x5d, 0xfd, 0x5d, 0x53, 0x56, 0xd4, 0x55, 0xe3, 0x39, 0x2b, 0x98,
+	0x6c, 0xf9, 0x28, 0x5e, 0xe7, 0x4a, 0x2b, 0x40, 0x74, 0xb9, 0xa4, 0x71, 0xcf, 0xa7, 0xac, 0x58,
+	0x4c, 0x35, 0xa0, 0x39, 0xde, 0xee, 0xad, 0x53, 0x22, 0x3e, 0x29, 0x03, 0x1d, 0xc0, 0x56, 0x94,
+	0xf3, 0x68, 0x83, 0xd2, 0xd4, 0x1b, 0x2e, 0x54, 0xb1, 0x83, 0xf8, 0x06, 0xf6, 0x9a, 0xbe, 0xef,
+	0x43, 0x3f, 0xd7, 0xf9, 0x5d, 0x1d, 0x38, 0xb9, 0xc3, 0x7f, 0x07, 0x26, 0x51, 0xce, 0x79, 0x61,
+	0x83, 0xee, 0xa5, 0x11, 0xe8, 0x10, 0xc6, 0x39, 0x4f, 0x85, 0xbd, 0x70, 0x47, 0xde, 0xe2, 0x78,
+	0xef, 0xfe, 0x91, 0x9c, 0xf3, 0x14, 0xeb, 0x58, 0x3b, 0x0b, 0x06, 0x13, 0x5d, 0x44, 0x36, 0xcc,
+	0x3a, 0x42, 0xcd, 0x0c, 0x3a, 0xa9, 0x2e, 0x94, 0xe4, 0x25, 0x8b, 0x85, 0x3d, 0x74, 0x47, 0x9e,
+	0x85, 0x5b, 0xa5, 0x06, 0x90, 0x10, 0x49, 0xba, 0x01, 0xa8, 0xb5, 0xfa, 0xb3, 0xcd, 0xbd, 0x1c,
+	0xe3, 0x46, 0x34, 0x47, 0xbd, 0xff, 0xf0, 0xfb, 0xc6, 0x31, 0xae, 0x6f, 0x1c, 0xe3, 0xef, 0x8d,
+	0x63, 0xfc, 0xb8, 0x75, 0x06, 0xd7, 0xb7, 0xce, 0xe0, 0xcf, 0xad, 0x33, 0xf8, 0xf2, 0x32, 0x65,
+	0x32, 0x5b, 0x47, 0x7e, 0xcc, 0x8b, 0xe0, 0x8c, 0xe6, 0x8c, 0x8b, 0xc3, 0x93, 0x8c, 0xb0, 0xd5,
+	0xe1, 0x39, 0x89, 0x44, 0xf0, 0xdf, 0xd3, 0x8c, 0xa6, 0xfa, 0x99, 0xbd, 0xfa, 0x37, 0x00, 0x58,
+	0x8f, 0x1f, 0x4b, 0xb4, 0x03, 0x00, 0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -126,6 +257,18 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndexer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintIndexer(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -169,6 +312,156 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndexer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Bloom) > 0 {
+		i -= len(m.Bloom)
+		copy(dAtA[i:], m.Bloom)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Bloom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BlockCumulativeGasUsed != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.BlockCumulativeGasUsed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlobGasUsed != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.BlobGasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.EffectiveGasPrice) > 0 {
+		i -= len(m.EffectiveGasPrice)
+		copy(dAtA[i:], m.EffectiveGasPrice)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.EffectiveGasPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TxType != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintIndexer(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIndexer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIndexer(v)
 	base := offset
@@ -207,6 +500,87 @@ func (m *TxResult) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovIndexer(uint64(m.CumulativeGasUsed))
 	}
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	return n
+}
+
+func (m *TxReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.TxType != 0 {
+		n += 1 + sovIndexer(uint64(m.TxType))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovIndexer(uint64(m.GasLimit))
+	}
+	l = len(m.EffectiveGasPrice)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.BlobGasUsed != 0 {
+		n += 1 + sovIndexer(uint64(m.BlobGasUsed))
+	}
+	if m.BlockCumulativeGasUsed != 0 {
+		n += 1 + sovIndexer(uint64(m.BlockCumulativeGasUsed))
+	}
+	l = len(m.Bloom)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovIndexer(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovIndexer(uint64(m.Index))
+	}
 	return n
 }
 
@@ -379,6 +753,573 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &TxReceipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
+			if m.From == nil {
+				m.From = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = append(m.To[:0], dAtA[iNdEx:postIndex]...)
+			if m.To == nil {
+				m.To = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = append(m.ContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractAddress == nil {
+				m.ContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobGasUsed", wireType)
+			}
+			m.BlobGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCumulativeGasUsed", wireType)
+			}
+			m.BlockCumulativeGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCumulativeGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bloom == nil {
+				m.Bloom = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &TxLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndexer(dAtA[iNdEx:])
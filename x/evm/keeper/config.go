@@ -58,6 +58,8 @@ type EVMConfig struct {
 	DebugTrace     bool
 	Overrides      *rpctypes.StateOverride
 	BlockOverrides *rpctypes.BlockOverrides
+	// SetCodeAuthorizations is the EIP-7702 authorization list applied before the message
+	SetCodeAuthorizations []types.SetCodeAuthorization
 }
 
 // EVMBlockConfig creates the EVMBlockConfig based on current state
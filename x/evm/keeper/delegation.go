@@ -0,0 +1,125 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"unsafe"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// delegationHooks wraps the parent hooks to set the account of each call frame as the call target of the
+// state, so the EIP-7702 delegated accounts execute the code of their delegation target, while the EXTCODE*
+// opcodes see the delegation designator. The parent hooks are still called.
+func delegationHooks(db *statedb.StateDB, parent *tracing.Hooks) *tracing.Hooks {
+	var hooks tracing.Hooks
+	if parent != nil {
+		hooks = *parent
+	}
+	onEnter, onExit := hooks.OnEnter, hooks.OnExit
+	hooks.OnEnter = func(depth int, typ byte, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+		if onEnter != nil {
+			onEnter(depth, typ, from, to, input, gas, value)
+		}
+		// set after the parent hooks, which may read the code of the account
+		switch vm.OpCode(typ) {
+		case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+			db.SetCallTarget(&to)
+		}
+	}
+	hooks.OnExit = func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+		// the frames which don't load any code, like the precompiled contracts, leave the target set
+		db.SetCallTarget(nil)
+		if onExit != nil {
+			onExit(depth, output, gasUsed, err, reverted)
+		}
+	}
+	return &hooks
+}
+
+// gasFunc is the signature of the dynamic gas functions of the go-ethereum jump table.
+type gasFunc func(evm *vm.EVM, contract *vm.Contract, stack *vm.Stack, mem *vm.Memory, memorySize uint64) (uint64, error)
+
+// enableDelegationGas charges the access of the EIP-7702 delegation target in the call opcodes, like the
+// Prague instruction set of go-ethereum. go-ethereum v1.14 doesn't allow to change the jump table, so the
+// interpreter's table is replaced by a copy with the call operations wrapped.
+func enableDelegationGas(evm *vm.EVM) {
+	interpreter := reflect.ValueOf(evm.Interpreter()).Elem()
+	tableField := interpreter.FieldByName("table")
+	if tableField.Type() != reflect.TypeOf((*vm.JumpTable)(nil)) {
+		panic(fmt.Sprintf("unexpected interpreter jump table type %s", tableField.Type()))
+	}
+	tablePtr := (**vm.JumpTable)(unsafe.Pointer(tableField.UnsafeAddr())) //#nosec G103 -- the field type is checked above
+
+	// the operations are shared by all the interpreters of the fork, they are copied before being changed
+	table := **tablePtr
+	for _, op := range []vm.OpCode{vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL} {
+		operation := *table[op]
+		field := reflect.ValueOf(&operation).Elem().FieldByName("dynamicGas")
+		if !field.IsValid() || !field.Type().ConvertibleTo(reflect.TypeOf(gasFunc(nil))) {
+			panic(fmt.Sprintf("unexpected dynamic gas of %s", op))
+		}
+		dynamicGas := reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem() //#nosec G103 -- the field type is checked above
+		parent := dynamicGas.Convert(reflect.TypeOf(gasFunc(nil))).Interface().(gasFunc)
+		dynamicGas.Set(reflect.ValueOf(delegationCallGas(parent)).Convert(field.Type()))
+		table[op] = &operation
+	}
+	*tablePtr = &table
+}
+
+// delegationCallGas charges the warm or cold access of the delegation target when the called account is
+// delegated. Like the cold access of the account, the cost is charged before the 63/64 rule applied by the
+// parent function, and it's added to the returned gas so it's reported to the tracers.
+func delegationCallGas(parent gasFunc) gasFunc {
+	return func(evm *vm.EVM, contract *vm.Contract, stack *vm.Stack, mem *vm.Memory, memorySize uint64) (uint64, error) {
+		addr := common.Address(stack.Back(1).Bytes20())
+		target, ok := types.ParseDelegation(evm.StateDB.GetCode(addr))
+		if !ok {
+			return parent(evm, contract, stack, mem, memorySize)
+		}
+
+		cost := params.WarmStorageReadCostEIP2929
+		if !evm.StateDB.AddressInAccessList(target) {
+			evm.StateDB.AddAddressToAccessList(target)
+			cost = params.ColdAccountAccessCostEIP2929
+		}
+		if !contract.UseGas(cost, evm.Config.Tracer, tracing.GasChangeCallStorageColdAccess) {
+			return 0, vm.ErrOutOfGas
+		}
+		gas, err := parent(evm, contract, stack, mem, memorySize)
+		if err != nil {
+			return gas, err
+		}
+
+		// the interpreter charges the returned gas
+		contract.Gas += cost
+		gas, overflow := math.SafeAdd(gas, cost)
+		if overflow {
+			return 0, vm.ErrGasUintOverflow
+		}
+		return gas, nil
+	}
+}
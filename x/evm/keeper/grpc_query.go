@@ -16,11 +16,13 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	cosmostracing "github.com/Helios-Chain-Labs/ethermint/x/evm/tracing"
@@ -32,13 +34,17 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
@@ -67,6 +73,11 @@ func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*typ
 	addr := common.HexToAddress(req.Address)
 
 	ctx := sdk.UnwrapSDKContext(c)
+	ctx, err := k.applyPendingTxs(ctx, k.eip155ChainID, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	acct := k.GetAccountOrEmpty(ctx, addr)
 	balance := k.GetEVMDenomBalance(ctx, addr)
 
@@ -158,6 +169,10 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	ctx, err := k.applyPendingTxs(ctx, k.eip155ChainID, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	balanceInt := k.GetEVMDenomBalance(ctx, common.HexToAddress(req.Address))
 
@@ -180,6 +195,10 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	ctx, err := k.applyPendingTxs(ctx, k.eip155ChainID, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	address := common.HexToAddress(req.Address)
 	key := common.HexToHash(req.Key)
@@ -206,6 +225,10 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	ctx, err := k.applyPendingTxs(ctx, k.eip155ChainID, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	address := common.HexToAddress(req.Address)
 	acct := k.GetAccount(ctx, address)
@@ -248,6 +271,10 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	ctx, err = k.applyPendingTxs(ctx, chainID, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	cfg, err := k.EVMConfig(ctx, chainID, common.Hash{})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -261,6 +288,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 
 		cfg.Overrides = &overrides
 	}
+	cfg.SetCodeAuthorizations = args.AuthorizationList
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -292,6 +320,10 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	ctx, err = k.applyPendingTxs(ctx, chainID, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	if req.GasCap < ethparams.TxGas {
 		return nil, status.Error(codes.InvalidArgument, "gas cap cannot be lower than 21,000")
@@ -334,6 +366,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	cfg.SetCodeAuthorizations = args.AuthorizationList
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -406,6 +439,121 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api, the call is executed with the access list tracer
+// repeatedly, until the access list accessed by the call don't change anymore.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.QueryCreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithProposer(GetProposerAddress(ctx, req.ProposerAddress))
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, err = k.applyPendingTxs(ctx, chainID, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, chainID, common.Hash{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(req.Overrides) > 0 {
+		var overrides rpctypes.StateOverride
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cfg.Overrides = &overrides
+	}
+	cfg.SetCodeAuthorizations = args.AuthorizationList
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+	// the precompiled contracts don't need to be in the access list
+	precompiles := k.activePrecompiles(ctx, cfg.Params, cfg.Rules)
+
+	var accessList ethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+	for {
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		cfg.Tracer = &cosmostracing.Hooks{Hooks: tracer.Hooks()}
+
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, cfg, false)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if tracer.Equal(prevTracer) {
+			return &types.QueryCreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
+// SimulateV1 implements eth_simulateV1 rpc api, the calls of the simulated blocks are executed in order
+// on top of the requested block, each call sees the state changes of the previous ones.
+func (k Keeper) SimulateV1(c context.Context, req *types.QuerySimulateV1Request) (*types.QuerySimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithProposer(GetProposerAddress(ctx, req.ProposerAddress))
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, chainID, common.Hash{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sim := &simulator{keeper: &k, cfg: cfg, opts: &opts, gasCap: req.GasCap}
+	blocks, err := sim.execute(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	data, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySimulateV1Response{Data: data}, nil
+}
+
 type traceRequest interface {
 	comparable
 	GetTraceConfig() *types.TraceConfig
@@ -487,12 +635,12 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 			signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), cfg.BlockTime)
 
 			for i, tx := range req.Predecessors {
-				ethTx := tx.AsTransaction()
-				msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+				msg, err := tx.ToMessage(signer, cfg.BaseFee)
 				if err != nil {
 					continue
 				}
-				cfg.TxConfig.TxHash = ethTx.Hash()
+				cfg.TxConfig.TxHash = tx.Hash()
+				cfg.SetCodeAuthorizations = tx.SetCodeAuthorizations()
 				cfg.TxConfig.TxIndex = uint(i)
 				rsp, err := k.ApplyMessageWithConfig(ctx, msg, cfg, true)
 				if err != nil {
@@ -501,13 +649,13 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 				cfg.TxConfig.LogIndex += uint(len(rsp.Logs))
 			}
 
-			tx := req.Msg.AsTransaction()
-			cfg.TxConfig.TxHash = tx.Hash()
+			cfg.TxConfig.TxHash = req.Msg.Hash()
+			cfg.SetCodeAuthorizations = req.Msg.SetCodeAuthorizations()
 			if len(req.Predecessors) > 0 {
 				cfg.TxConfig.TxIndex++
 			}
 
-			return core.TransactionToMessage(tx, signer, cfg.BaseFee)
+			return req.Msg.ToMessage(signer, cfg.BaseFee)
 		},
 	)
 	if err != nil {
@@ -558,10 +706,10 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		cfg.TxConfig.TxHash = ethTx.Hash()
+		cfg.TxConfig.TxHash = tx.Hash()
 		cfg.TxConfig.TxIndex = uint(i)
-		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		cfg.SetCodeAuthorizations = tx.SetCodeAuthorizations()
+		msg, err := tx.ToMessage(signer, cfg.BaseFee)
 		if err != nil {
 			result.Error = status.Error(codes.Internal, err.Error()).Error()
 		} else {
@@ -762,6 +910,244 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// IntermediateRoots implements the Query/IntermediateRoots gRPC method, it re-executes the
+// transactions on top of the state of the block beginning and returns a state fingerprint
+// after each of them.
+// The fingerprint chains the previous one with the sorted write set the transaction
+// produced in the multistore, so two nodes return the same roots only if every transaction
+// wrote the same state.
+// Like the block traces, a failing transaction doesn't fail the request, its error is
+// recorded and its writes are discarded, so its root is the one of the previous transaction.
+func (k Keeper) IntermediateRoots(c context.Context, req *types.QueryIntermediateRootsRequest) (*types.QueryIntermediateRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// get the context of block beginning
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx = ctx.WithProposer(GetProposerAddress(ctx, req.ProposerAddress))
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, chainID, common.Hash{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), cfg.BlockTime)
+
+	// branch the store so the writes of each transaction are traced when they are flushed
+	recorder := &writeSetRecorder{}
+	store := ctx.MultiStore().CacheMultiStore().SetTracer(recorder)
+
+	roots := make([]string, 0, len(req.Txs))
+	txErrors := make([]string, 0, len(req.Txs))
+	var root common.Hash
+	for i, tx := range req.Txs {
+		cfg.TxConfig.TxHash = tx.Hash()
+		cfg.TxConfig.TxIndex = uint(i)
+
+		// the nonce, fee and refund steps of the AnteHandler are applied, so the root includes the changes of
+		// the sender's account like the committed state
+		recorder.Reset()
+		txStore := store.CacheMultiStore()
+		res, err := k.applyPendingTx(ctx.WithMultiStore(txStore), cfg, signer, tx)
+		if err != nil {
+			roots = append(roots, root.Hex())
+			txErrors = append(txErrors, err.Error())
+			continue
+		}
+		txStore.Write()
+
+		cfg.TxConfig.LogIndex += uint(len(res.Logs))
+		root = recorder.Digest(root)
+		roots = append(roots, root.Hex())
+		txErrors = append(txErrors, "")
+	}
+
+	return &types.QueryIntermediateRootsResponse{
+		Roots:  roots,
+		Errors: txErrors,
+	}, nil
+}
+
+// writeSetRecorder collects the write and delete operations traced by the KVStores.
+type writeSetRecorder struct {
+	ops [][]byte
+}
+
+// Write implements io.Writer, it's called once per traced operation, followed by a newline.
+func (r *writeSetRecorder) Write(p []byte) (int, error) {
+	var op struct {
+		Operation string `json:"operation"`
+	}
+	if err := json.Unmarshal(p, &op); err != nil {
+		// the newline separator
+		return len(p), nil
+	}
+	if op.Operation == "write" || op.Operation == "delete" {
+		r.ops = append(r.ops, common.CopyBytes(p))
+	}
+	return len(p), nil
+}
+
+// Reset drops the recorded operations.
+func (r *writeSetRecorder) Reset() {
+	r.ops = r.ops[:0]
+}
+
+// Digest hashes the parent root together with the recorded operations, the operations are
+// sorted because the stores of a multistore are flushed in random order.
+func (r *writeSetRecorder) Digest(parent common.Hash) common.Hash {
+	sort.Slice(r.ops, func(i, j int) bool {
+		return bytes.Compare(r.ops[i], r.ops[j]) < 0
+	})
+	hasher := crypto.NewKeccakState()
+	hasher.Write(parent.Bytes())
+	for _, op := range r.ops {
+		hasher.Write(op)
+	}
+	var root common.Hash
+	hasher.Read(root[:])
+	return root
+}
+
+// StorageRange implements the Query/StorageRange gRPC method, it pages through the storage of a
+// contract ordered by the raw slot.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	storage := types.Storage{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AccountRangeMaxStorage is the maximum number of storage slots returned per account by AccountRange,
+// the rest of the storage is paged with StorageRange from the next storage key of the account.
+const AccountRangeMaxStorage = 256
+
+// AccountRange implements the Query/AccountRange gRPC method, it returns the accounts ordered by
+// address starting from the pagination key. The module accounts with 32 bytes addresses are skipped
+// since they are not reachable from the EVM.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var start []byte
+	limit := uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+		}
+		start = req.Pagination.Key
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	accounts := []types.RangeAccount{}
+	nextKey := start
+	// the accounts are paged in the order of the raw address, the pages are queried until the limit is reached
+	// since the skipped module accounts leave them short
+	for {
+		res, err := k.accountQuerier.Accounts(ctx, &authtypes.QueryAccountsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: limit - uint64(len(accounts))},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, acctAny := range res.Accounts {
+			var acct sdk.AccountI
+			if err := k.cdc.UnpackAny(acctAny, &acct); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if len(acct.GetAddress()) != common.AddressLength {
+				continue
+			}
+			accounts = append(accounts, k.rangeAccount(ctx, acct, req.NoCode, req.NoStorage))
+		}
+		nextKey = nil
+		if res.Pagination != nil {
+			nextKey = res.Pagination.NextKey
+		}
+		if len(nextKey) == 0 || uint64(len(accounts)) == limit {
+			break
+		}
+	}
+
+	return &types.QueryAccountRangeResponse{
+		Accounts:   accounts,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// rangeAccount loads the evm state of the account
+func (k Keeper) rangeAccount(ctx sdk.Context, acct sdk.AccountI, noCode, noStorage bool) types.RangeAccount {
+	address := common.BytesToAddress(acct.GetAddress())
+	codeHash := common.BytesToHash(types.EmptyCodeHash)
+	if ethAcct, ok := acct.(ethermint.EthAccountI); ok {
+		codeHash = ethAcct.GetCodeHash()
+	}
+
+	res := types.RangeAccount{
+		Address:  address.Hex(),
+		Balance:  sdkmath.NewIntFromBigInt(k.GetEVMDenomBalance(ctx, address)),
+		Nonce:    acct.GetSequence(),
+		CodeHash: codeHash.Hex(),
+	}
+	if !noCode && !bytes.Equal(codeHash.Bytes(), types.EmptyCodeHash) {
+		res.Code = k.GetCode(ctx, codeHash)
+	}
+	if !noStorage {
+		res.Storage = types.Storage{}
+		k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			if len(res.Storage) == AccountRangeMaxStorage {
+				res.NextStorageKey = key.Hex()
+				return false
+			}
+			res.Storage = append(res.Storage, types.NewState(key, value))
+			return true
+		})
+	}
+	return res
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
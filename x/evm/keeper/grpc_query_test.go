@@ -1,14 +1,18 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -17,10 +21,12 @@ import (
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/Helios-Chain-Labs/ethermint/app"
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
 	"github.com/Helios-Chain-Labs/ethermint/server/config"
 	"github.com/Helios-Chain-Labs/ethermint/tests"
 	"github.com/Helios-Chain-Labs/ethermint/testutil"
 	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/keeper"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
@@ -1227,6 +1233,71 @@ func (suite *GRPCServerTestSuiteSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *GRPCServerTestSuiteSuite) TestIntermediateRoots() {
+	suite.SetupTest()
+	chainID := suite.App.EvmKeeper.ChainID()
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	recipient := tests.GenerateAddress()
+	amount := big.NewInt(1000)
+	gasPrice := suite.App.FeeMarketKeeper.GetBaseFee(suite.Ctx)
+	if gasPrice == nil {
+		gasPrice = big.NewInt(0)
+	}
+	balance := sdkmath.NewIntWithDecimal(1, 18).BigInt()
+	suite.Require().NoError(suite.App.EvmKeeper.SetBalance(suite.Ctx, suite.Address, balance, types.DefaultEVMDenom))
+	nonce := suite.App.EvmKeeper.GetNonce(suite.Ctx, suite.Address)
+
+	// the transactions of the block are replayed on the state before it
+	newTx := func(nonce uint64, gasPrice *big.Int) *types.MsgEthereumTx {
+		tx := types.NewTx(chainID, nonce, &recipient, amount, ethparams.TxGas, gasPrice, nil, nil, nil, nil)
+		tx.From = suite.Address.Bytes()
+		suite.Require().NoError(tx.Sign(signer, suite.Signer))
+		return tx
+	}
+	firstTx, secondTx := newTx(nonce, gasPrice), newTx(nonce+1, gasPrice)
+
+	req := &types.QueryIntermediateRootsRequest{
+		Txs: []*types.MsgEthereumTx{firstTx, secondTx},
+	}
+	res, err := suite.EvmQueryClient.IntermediateRoots(suite.Ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Roots, 2)
+	suite.Require().NotEqual(common.Hash{}.Hex(), res.Roots[0])
+	suite.Require().NotEqual(res.Roots[0], res.Roots[1])
+
+	// re-execution is deterministic
+	again, err := suite.EvmQueryClient.IntermediateRoots(suite.Ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Roots, again.Roots)
+
+	// the root of a transaction only depends on the transactions before it
+	single, err := suite.EvmQueryClient.IntermediateRoots(suite.Ctx, &types.QueryIntermediateRootsRequest{
+		Txs: []*types.MsgEthereumTx{firstTx},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Roots[:1], single.Roots)
+
+	// the fee paid by the sender is part of the root
+	higherFee, err := suite.EvmQueryClient.IntermediateRoots(suite.Ctx, &types.QueryIntermediateRootsRequest{
+		Txs: []*types.MsgEthereumTx{newTx(nonce, new(big.Int).Add(gasPrice, big.NewInt(1)))},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(single.Roots, higherFee.Roots)
+
+	// the transactions are checked like in the AnteHandler, a failing one is recorded without failing the
+	// block, its writes are discarded so its root is the previous one
+	failing, err := suite.EvmQueryClient.IntermediateRoots(suite.Ctx, &types.QueryIntermediateRootsRequest{
+		Txs: []*types.MsgEthereumTx{firstTx, newTx(nonce+5, gasPrice), secondTx},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{res.Roots[0], res.Roots[0], res.Roots[1]}, failing.Roots)
+	suite.Require().Len(failing.Errors, 3)
+	suite.Require().Empty(failing.Errors[0])
+	suite.Require().Contains(failing.Errors[1], "invalid nonce")
+	suite.Require().Empty(failing.Errors[2])
+	suite.Require().Equal([]string{"", ""}, res.Errors)
+}
+
 func (suite *GRPCServerTestSuiteSuite) TestNonceInQuery() {
 	suite.SetupTest()
 	address := tests.GenerateAddress()
@@ -1262,6 +1333,107 @@ func (suite *GRPCServerTestSuiteSuite) TestNonceInQuery() {
 	suite.Require().NoError(err)
 }
 
+func (suite *GRPCServerTestSuiteSuite) TestPendingTxs() {
+	suite.SetupTest()
+	chainID := suite.App.EvmKeeper.ChainID()
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	recipient := tests.GenerateAddress()
+	amount := big.NewInt(1000)
+	gasPrice := suite.App.FeeMarketKeeper.GetBaseFee(suite.Ctx)
+	if gasPrice == nil {
+		gasPrice = big.NewInt(0)
+	}
+	balance := sdkmath.NewIntWithDecimal(1, 18).BigInt()
+	suite.Require().NoError(suite.App.EvmKeeper.SetBalance(suite.Ctx, suite.Address, balance, types.DefaultEVMDenom))
+	nonce := suite.App.EvmKeeper.GetNonce(suite.Ctx, suite.Address)
+
+	newTx := func(nonce uint64, to *common.Address, value *big.Int, gasLimit uint64, data []byte) *types.MsgEthereumTx {
+		tx := types.NewTx(chainID, nonce, to, value, gasLimit, gasPrice, nil, nil, data, nil)
+		tx.From = suite.Address.Bytes()
+		suite.Require().NoError(tx.Sign(signer, suite.Signer))
+		return tx
+	}
+
+	// the second transfer reuses the nonce of the first one and is skipped
+	transfers := []*types.MsgEthereumTx{
+		newTx(nonce, &recipient, amount, ethparams.TxGas, nil),
+		newTx(nonce, &recipient, amount, ethparams.TxGas, nil),
+		newTx(nonce+1, &recipient, amount, ethparams.TxGas, nil),
+	}
+
+	acct, err := suite.EvmQueryClient.Account(suite.Ctx, &types.QueryAccountRequest{
+		Address:    suite.Address.Hex(),
+		PendingTxs: transfers,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(nonce+2, acct.Nonce)
+	cost := new(big.Int).Mul(gasPrice, big.NewInt(int64(ethparams.TxGas)))
+	cost.Add(cost, amount).Mul(cost, big.NewInt(2))
+	suite.Require().Equal(new(big.Int).Sub(balance, cost).String(), acct.Balance)
+
+	res, err := suite.EvmQueryClient.Balance(suite.Ctx, &types.QueryBalanceRequest{
+		Address:    recipient.Hex(),
+		PendingTxs: transfers,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(new(big.Int).Mul(amount, big.NewInt(2)).String(), res.Balance)
+
+	// the contract deployed by a pending tx can be called
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.Address, supply)
+	suite.Require().NoError(err)
+	pendingTxs := append(transfers, newTx(nonce+2, nil, nil, 3_000_000, append(types.ERC20Contract.Bin, ctorArgs...)))
+	contractAddr := crypto.CreateAddress(suite.Address, nonce+2)
+
+	code, err := suite.EvmQueryClient.Code(suite.Ctx, &types.QueryCodeRequest{
+		Address:    contractAddr.Hex(),
+		PendingTxs: pendingTxs,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(code.Code)
+
+	data, err := types.ERC20Contract.ABI.Pack("balanceOf", suite.Address)
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, Data: (*hexutil.Bytes)(&data)})
+	suite.Require().NoError(err)
+	callRes, err := suite.EvmQueryClient.EthCall(suite.Ctx, &types.EthCallRequest{
+		Args:       args,
+		GasCap:     uint64(config.DefaultGasCap),
+		PendingTxs: pendingTxs,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(callRes.VmError)
+	suite.Require().Equal(common.BigToHash(supply).Bytes(), callRes.Ret)
+
+	// the committed state is untouched
+	suite.Require().Equal(nonce, suite.App.EvmKeeper.GetNonce(suite.Ctx, suite.Address))
+	suite.Require().Equal(balance, suite.App.EvmKeeper.GetEVMDenomBalance(suite.Ctx, suite.Address))
+	suite.Require().Equal(int64(0), suite.App.EvmKeeper.GetEVMDenomBalance(suite.Ctx, recipient).Int64())
+	suite.Require().Nil(suite.App.EvmKeeper.GetAccount(suite.Ctx, contractAddr))
+
+	// at most PendingTxsMaxCount transactions are applied
+	many := make([]*types.MsgEthereumTx, types.PendingTxsMaxCount+1)
+	for i := range many {
+		many[i] = newTx(nonce+uint64(i), &recipient, big.NewInt(1), ethparams.TxGas, nil)
+	}
+	acct, err = suite.EvmQueryClient.Account(suite.Ctx, &types.QueryAccountRequest{
+		Address:    suite.Address.Hex(),
+		PendingTxs: many,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(nonce+types.PendingTxsMaxCount, acct.Nonce)
+
+	// the gas limits of the applied transactions can't exceed the block gas limit
+	consensusParams := *testutil.DefaultConsensusParams
+	consensusParams.Block = &cmtproto.BlockParams{MaxBytes: 1048576, MaxGas: int64(2 * ethparams.TxGas)}
+	acct, err = suite.App.EvmKeeper.Account(suite.Ctx.WithConsensusParams(consensusParams), &types.QueryAccountRequest{
+		Address:    suite.Address.Hex(),
+		PendingTxs: many,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(nonce+2, acct.Nonce)
+}
+
 func (suite *GRPCServerTestSuiteSuite) TestQueryBaseFee() {
 	var (
 		aux    sdkmath.Int
@@ -1409,6 +1581,335 @@ func (suite *GRPCServerTestSuiteSuite) TestEthCall() {
 	}
 }
 
+func (suite *GRPCServerTestSuiteSuite) TestEthCallSetCode() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(key.PublicKey)
+	target := tests.GenerateAddress()
+
+	// ADDRESS PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	vmdb := suite.StateDB()
+	vmdb.SetCode(target, common.FromHex("0x3060005260206000f3"))
+	suite.Require().NoError(vmdb.Commit())
+
+	params := suite.App.EvmKeeper.GetParams(suite.Ctx)
+	zero := sdkmath.ZeroInt()
+	params.ChainConfig.CancunTime = &zero
+	params.ChainConfig.PragueTime = &zero
+	suite.Require().NoError(suite.App.EvmKeeper.SetParams(suite.Ctx, params))
+	suite.App.EvmKeeper.RemoveParamsCache(suite.Ctx)
+
+	auth, err := types.SignSetCode(key, types.SetCodeAuthorization{
+		Address: target,
+		Nonce:   0,
+	})
+	suite.Require().NoError(err)
+
+	args, err := json.Marshal(&types.TransactionArgs{
+		From:              &suite.Address,
+		To:                &authority,
+		AuthorizationList: []types.SetCodeAuthorization{auth},
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.EvmQueryClient.EthCall(suite.Ctx, &types.EthCallRequest{Args: args, GasCap: uint64(config.DefaultGasCap)})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+	// the delegated code runs in the context of the authority
+	suite.Require().Equal(common.LeftPadBytes(authority.Bytes(), 32), res.Ret)
+
+	// the state is not committed
+	suite.Require().Equal(uint64(0), suite.App.EvmKeeper.GetNonce(suite.Ctx, authority))
+}
+
+func (suite *GRPCServerTestSuiteSuite) TestEthCallDelegatedAccount() {
+	authority := tests.GenerateAddress()
+	target := tests.GenerateAddress()
+	designator := types.AddressToDelegation(target)
+
+	// ADDRESS PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	vmdb := suite.StateDB()
+	vmdb.SetCode(target, common.FromHex("0x3060005260206000f3"))
+	vmdb.SetCode(authority, designator)
+	suite.Require().NoError(vmdb.Commit())
+
+	params := suite.App.EvmKeeper.GetParams(suite.Ctx)
+	zero := sdkmath.ZeroInt()
+	params.ChainConfig.CancunTime = &zero
+	params.ChainConfig.PragueTime = &zero
+	suite.Require().NoError(suite.App.EvmKeeper.SetParams(suite.Ctx, params))
+	suite.App.EvmKeeper.RemoveParamsCache(suite.Ctx)
+
+	// call runs the code of a new contract and returns its output
+	call := func(code string, accessList *ethtypes.AccessList) []byte {
+		contract := tests.GenerateAddress()
+		vmdb := suite.StateDB()
+		vmdb.SetCode(contract, common.FromHex(code))
+		suite.Require().NoError(vmdb.Commit())
+
+		args, err := json.Marshal(&types.TransactionArgs{From: &suite.Address, To: &contract, AccessList: accessList})
+		suite.Require().NoError(err)
+		res, err := suite.EvmQueryClient.EthCall(suite.Ctx, &types.EthCallRequest{Args: args, GasCap: uint64(config.DefaultGasCap)})
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		return res.Ret
+	}
+	push20 := func(addr common.Address) string {
+		return "73" + hex.EncodeToString(addr.Bytes())
+	}
+
+	// the EXTCODE* opcodes see the designator
+	// PUSH20 authority EXTCODESIZE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	ret := call(push20(authority)+"3b60005260206000f3", nil)
+	suite.Require().Equal(common.LeftPadBytes([]byte{byte(len(designator))}, 32), ret)
+	// PUSH20 authority EXTCODEHASH PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	ret = call(push20(authority)+"3f60005260206000f3", nil)
+	suite.Require().Equal(crypto.Keccak256(designator), ret)
+	// PUSH1 23 PUSH1 0 PUSH1 0 PUSH20 authority EXTCODECOPY PUSH1 23 PUSH1 0 RETURN
+	ret = call("601760006000"+push20(authority)+"3c60176000f3", nil)
+	suite.Require().Equal(designator, ret)
+
+	// the calls execute the delegated code and are charged the access of the delegation target
+	// PUSH1 32 PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH20 addr GAS CALL POP GAS PUSH1 32 MSTORE PUSH1 64 PUSH1 0 RETURN
+	callCode := func(addr common.Address) string {
+		return "60206000600060006000" + push20(addr) + "5af1505a60205260406000f3"
+	}
+	gasLeft := func(ret []byte) uint64 {
+		return new(big.Int).SetBytes(ret[32:]).Uint64()
+	}
+	direct := call(callCode(target), nil)
+	suite.Require().Equal(common.LeftPadBytes(target.Bytes(), 32), direct[:32])
+	delegated := call(callCode(authority), nil)
+	suite.Require().Equal(common.LeftPadBytes(authority.Bytes(), 32), delegated[:32])
+	suite.Require().Equal(ethparams.ColdAccountAccessCostEIP2929, gasLeft(direct)-gasLeft(delegated))
+
+	accessList := &ethtypes.AccessList{{Address: authority, StorageKeys: []common.Hash{}}, {Address: target, StorageKeys: []common.Hash{}}}
+	direct = call(callCode(target), accessList)
+	delegated = call(callCode(authority), accessList)
+	suite.Require().Equal(ethparams.WarmStorageReadCostEIP2929, gasLeft(direct)-gasLeft(delegated))
+}
+
+func (suite *GRPCServerTestSuiteSuite) TestSimulateV1() {
+	sender := tests.GenerateAddress()
+	recipient := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	reverter := tests.GenerateAddress()
+	height := suite.Ctx.BlockHeight()
+
+	// PUSH1 42 PUSH1 0 MSTORE PUSH1 32 PUSH1 0 REVERT
+	opts := fmt.Sprintf(`{"traceTransfers": true, "blockStateCalls": [
+		{
+			"stateOverrides": {"%s": {"balance": "0x3e8"}, "%s": {"code": "0x602a60005260206000fd"}},
+			"calls": [{"from": "%s", "to": "%s", "value": "0x64"}, {"from": "%s", "to": "%s", "value": "0x32"}]
+		},
+		{
+			"blockOverrides": {"number": "%s"},
+			"calls": [{"from": "%s", "to": "%s"}]
+		}
+	]}`, sender, reverter, sender, recipient, recipient, other, hexutil.EncodeUint64(uint64(height+10)), sender, reverter)
+	res, err := suite.EvmQueryClient.SimulateV1(suite.Ctx, &types.QuerySimulateV1Request{
+		Opts:   []byte(opts),
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().NoError(err)
+
+	var blocks []struct {
+		Number     hexutil.Uint64           `json:"number"`
+		Hash       common.Hash              `json:"hash"`
+		ParentHash common.Hash              `json:"parentHash"`
+		GasUsed    hexutil.Uint64           `json:"gasUsed"`
+		Calls      []rpctypes.SimCallResult `json:"calls"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &blocks))
+	suite.Require().Len(blocks, 2)
+	suite.Require().Equal(uint64(height+1), uint64(blocks[0].Number))
+	suite.Require().Equal(uint64(height+10), uint64(blocks[1].Number))
+	suite.Require().Equal(blocks[0].Hash, blocks[1].ParentHash)
+
+	// the second call spends the value received in the first one
+	suite.Require().Len(blocks[0].Calls, 2)
+	suite.Require().Equal(2*ethparams.TxGas, uint64(blocks[0].GasUsed))
+	for i, call := range blocks[0].Calls {
+		suite.Require().Nil(call.Error)
+		suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), call.Status)
+		suite.Require().Len(call.Logs, 1)
+		suite.Require().Equal(blocks[0].Hash, call.Logs[0].BlockHash)
+		suite.Require().Equal(uint(i), call.Logs[0].Index)
+	}
+	transfer := blocks[0].Calls[1].Logs[0]
+	suite.Require().Equal(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), transfer.Address)
+	suite.Require().Equal(common.BytesToHash(recipient.Bytes()), transfer.Topics[1])
+	suite.Require().Equal(common.BytesToHash(other.Bytes()), transfer.Topics[2])
+	suite.Require().Equal(common.BigToHash(big.NewInt(50)).Bytes(), transfer.Data)
+
+	// the revert data is returned
+	suite.Require().Len(blocks[1].Calls, 1)
+	call := blocks[1].Calls[0]
+	suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), call.Status)
+	suite.Require().Equal(3, call.Error.Code)
+	suite.Require().Equal(hexutil.Encode(common.LeftPadBytes([]byte{42}, 32)), call.Error.Data)
+	suite.Require().Empty(call.Logs)
+
+	// the state is not committed
+	suite.Require().Zero(suite.App.EvmKeeper.GetBalance(suite.Ctx, sdk.AccAddress(other.Bytes()), types.DefaultEVMDenom).Sign())
+
+	// the nonce is checked in validation mode
+	opts = fmt.Sprintf(`{"validation": true, "blockStateCalls": [{"calls": [{"from": "%s", "to": "%s", "nonce": "0x1"}]}]}`,
+		sender, recipient)
+	_, err = suite.EvmQueryClient.SimulateV1(suite.Ctx, &types.QuerySimulateV1Request{
+		Opts:   []byte(opts),
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().ErrorContains(err, "nonce too high")
+}
+
+func (suite *GRPCServerTestSuiteSuite) TestCreateAccessList() {
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	// PUSH1 1 SLOAD POP PUSH20 other BALANCE POP STOP
+	code := "0x6001545073" + hex.EncodeToString(other.Bytes()) + "315000"
+	overrides := fmt.Sprintf(`{"%s": {"code": "%s"}}`, contract, code)
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &suite.Address, To: &contract})
+	suite.Require().NoError(err)
+	res, err := suite.EvmQueryClient.CreateAccessList(suite.Ctx, &types.EthCallRequest{
+		Args:      args,
+		GasCap:    config.DefaultGasCap,
+		Overrides: []byte(overrides),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+
+	// the recipient is accessed by the tx anyway, but its storage slots are listed
+	accessList := *res.AccessList.ToEthAccessList()
+	suite.Require().Len(accessList, 2)
+	suite.Require().ElementsMatch(
+		[]common.Address{contract, other},
+		[]common.Address{accessList[0].Address, accessList[1].Address},
+	)
+	for _, tuple := range accessList {
+		if tuple.Address == contract {
+			suite.Require().Equal([]common.Hash{common.BigToHash(big.NewInt(1))}, tuple.StorageKeys)
+		} else {
+			suite.Require().Empty(tuple.StorageKeys)
+		}
+	}
+
+	suite.Require().NotZero(res.GasUsed)
+}
+
+func (suite *GRPCServerTestSuiteSuite) TestStorageRange() {
+	vmdb := suite.StateDB()
+	var slots []common.Hash
+	for i := int64(1); i <= 3; i++ {
+		slot := common.BigToHash(big.NewInt(i))
+		vmdb.SetState(suite.Address, slot, common.BigToHash(big.NewInt(i*10)))
+		slots = append(slots, slot)
+	}
+	suite.Require().NoError(vmdb.Commit())
+
+	_, err := suite.EvmQueryClient.StorageRange(suite.Ctx, &types.QueryStorageRangeRequest{Address: invalidAddress})
+	suite.Require().Error(err)
+
+	res, err := suite.EvmQueryClient.StorageRange(suite.Ctx, &types.QueryStorageRangeRequest{
+		Address:    suite.Address.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Storage{
+		types.NewState(slots[0], common.BigToHash(big.NewInt(10))),
+		types.NewState(slots[1], common.BigToHash(big.NewInt(20))),
+	}, res.Storage)
+	suite.Require().Equal(slots[2].Bytes(), res.Pagination.NextKey)
+
+	res, err = suite.EvmQueryClient.StorageRange(suite.Ctx, &types.QueryStorageRangeRequest{
+		Address:    suite.Address.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Storage{
+		types.NewState(slots[2], common.BigToHash(big.NewInt(30))),
+	}, res.Storage)
+	suite.Require().Empty(res.Pagination.NextKey)
+}
+
+func (suite *GRPCServerTestSuiteSuite) TestAccountRange() {
+	contract := common.Address{}
+	code := []byte{0x60, 0x00}
+	slot := common.BigToHash(big.NewInt(1))
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contract, code)
+	vmdb.SetState(contract, slot, slot)
+	suite.Require().NoError(vmdb.Commit())
+
+	_, err := suite.EvmQueryClient.AccountRange(suite.Ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	suite.Require().Error(err)
+
+	// the zero address is the first one
+	res, err := suite.EvmQueryClient.AccountRange(suite.Ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	acct := res.Accounts[0]
+	suite.Require().Equal(contract.Hex(), acct.Address)
+	suite.Require().Equal(crypto.Keccak256Hash(code).Hex(), acct.CodeHash)
+	suite.Require().Equal(code, acct.Code)
+	suite.Require().Equal(types.Storage{types.NewState(slot, slot)}, acct.Storage)
+	suite.Require().Empty(acct.NextStorageKey)
+	suite.Require().Len(res.Pagination.NextKey, common.AddressLength)
+
+	res, err = suite.EvmQueryClient.AccountRange(suite.Ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+		NoCode:     true,
+		NoStorage:  true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Accounts[0].Code)
+	suite.Require().Empty(res.Accounts[0].Storage)
+
+	// the accounts are ordered by address
+	res, err = suite.EvmQueryClient.AccountRange(suite.Ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1000},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.Accounts)
+	suite.Require().Empty(res.Pagination.NextKey)
+	for i := 1; i < len(res.Accounts); i++ {
+		suite.Require().Less(strings.ToLower(res.Accounts[i-1].Address), strings.ToLower(res.Accounts[i].Address))
+	}
+	found := false
+	for _, acct := range res.Accounts {
+		found = found || acct.Address == suite.Address.Hex()
+	}
+	suite.Require().True(found)
+
+	// the storage returned per account is capped
+	vmdb = suite.StateDB()
+	for i := 0; i <= keeper.AccountRangeMaxStorage; i++ {
+		vmdb.SetState(contract, common.BigToHash(big.NewInt(int64(i+2))), slot)
+	}
+	suite.Require().NoError(vmdb.Commit())
+	res, err = suite.EvmQueryClient.AccountRange(suite.Ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts[0].Storage, keeper.AccountRangeMaxStorage)
+	suite.Require().NotEmpty(res.Accounts[0].NextStorageKey)
+
+	// the rest of the storage is paged from the next storage key
+	rest, err := suite.EvmQueryClient.StorageRange(suite.Ctx, &types.QueryStorageRangeRequest{
+		Address:    contract.Hex(),
+		Pagination: &query.PageRequest{Key: common.HexToHash(res.Accounts[0].NextStorageKey).Bytes()},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(rest.Storage, 2)
+	suite.Require().Equal(res.Accounts[0].NextStorageKey, rest.Storage[0].Key)
+	suite.Require().Less(res.Accounts[0].Storage[keeper.AccountRangeMaxStorage-1].Key, rest.Storage[0].Key)
+}
+
 func (suite *GRPCServerTestSuiteSuite) TestEmptyRequest() {
 	testCases := []struct {
 		name      string
@@ -1462,6 +1963,30 @@ func (suite *GRPCServerTestSuiteSuite) TestEmptyRequest() {
 				return suite.App.EvmKeeper.EstimateGas(suite.Ctx, nil)
 			},
 		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return suite.App.EvmKeeper.CreateAccessList(suite.Ctx, nil)
+			},
+		},
+		{
+			"StorageRange method",
+			func() (interface{}, error) {
+				return suite.App.EvmKeeper.StorageRange(suite.Ctx, nil)
+			},
+		},
+		{
+			"AccountRange method",
+			func() (interface{}, error) {
+				return suite.App.EvmKeeper.AccountRange(suite.Ctx, nil)
+			},
+		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return suite.App.EvmKeeper.SimulateV1(suite.Ctx, nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -29,15 +29,15 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 )
 
-// CustomContractFn defines a custom precompiled contract generator with ctx, rules and returns a precompiled contract.
-type CustomContractFn func(sdk.Context, params.Rules) vm.PrecompiledContract
+// CustomContractFn defines a custom precompiled contract generator with ctx, rules and returns a precompiled contract,
+// it returns nil if the contract is not available for the rules.
+type CustomContractFn func(sdk.Context, params.Rules) types.StatefulPrecompiledContract
 
 // Keeper grants access to the EVM module state and implements the go-ethereum StateDB interface.
 type Keeper struct {
@@ -56,6 +56,8 @@ type Keeper struct {
 	authority sdk.AccAddress
 	// access to account state
 	accountKeeper types.AccountKeeper
+	// page the accounts by address
+	accountQuerier types.AccountQuerier
 	// update balance and accounting operations with coins
 	bankKeeper types.BankKeeper
 	// access historical headers for EVM state transition execution
@@ -74,7 +76,8 @@ type Keeper struct {
 
 	// Legacy subspace
 	ss                paramstypes.Subspace
-	customContractFns []CustomContractFn
+	// custom precompiled contracts by address, enabled by the ActivePrecompiles param
+	customContractFns map[common.Address]CustomContractFn
 }
 
 // NewKeeper generates new evm module keeper
@@ -83,11 +86,12 @@ func NewKeeper(
 	storeKey, objectKey storetypes.StoreKey,
 	authority sdk.AccAddress,
 	ak types.AccountKeeper,
+	accountQuerier types.AccountQuerier,
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
 	fmk types.FeeMarketKeeper,
 	ss paramstypes.Subspace,
-	customContractFns []CustomContractFn,
+	customContractFns map[common.Address]CustomContractFn,
 ) *Keeper {
 	// ensure evm module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -104,6 +108,7 @@ func NewKeeper(
 		cdc:               cdc,
 		authority:         authority,
 		accountKeeper:     ak,
+		accountQuerier:    accountQuerier,
 		bankKeeper:        bankKeeper,
 		stakingKeeper:     sk,
 		feeMarketKeeper:   fmk,
@@ -48,7 +48,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	tx := msg.AsTransaction()
 
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", msg.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -121,7 +121,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, types.HexAddress(msg.From)),
-			sdk.NewAttribute(types.AttributeKeyTxType, strconv.Itoa(int(tx.Type()))),
+			sdk.NewAttribute(types.AttributeKeyTxType, strconv.Itoa(int(msg.TxType()))),
 		),
 	})
 
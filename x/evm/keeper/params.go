@@ -38,6 +38,9 @@ func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
 	if err := p.Validate(); err != nil {
 		return err
 	}
+	if err := k.validatePrecompiles(p); err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&p)
 	store.Set(types.KeyPrefixParams, bz)
@@ -0,0 +1,133 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// applyPendingTxs applies the unconfirmed transactions on a branch of the context and returns it,
+// so the pending state can be queried without touching the underlying store.
+// The transactions go through the same nonce, fee and refund steps as in the AnteHandler and
+// the message execution, the ones that would be rejected are skipped.
+// Like a block, at most types.PendingTxsMaxCount transactions are applied and their gas limits
+// can't exceed the block gas limit, the following transactions are ignored.
+func (k *Keeper) applyPendingTxs(ctx sdk.Context, chainID *big.Int, txs []*types.MsgEthereumTx) (sdk.Context, error) {
+	if len(txs) == 0 {
+		return ctx, nil
+	}
+	if len(txs) > types.PendingTxsMaxCount {
+		txs = txs[:types.PendingTxsMaxCount]
+	}
+	maxGas := ethermint.BlockGasLimit(ctx)
+
+	cfg, err := k.EVMConfig(ctx, chainID, common.Hash{})
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), cfg.BlockTime)
+
+	ctx, _ = ctx.CacheContext()
+	var gasLimit uint64
+	for i, tx := range txs {
+		var overflow bool
+		if gasLimit, overflow = math.SafeAdd(gasLimit, tx.GetGas()); overflow || (maxGas > 0 && gasLimit > maxGas) {
+			k.Logger(ctx).Debug("pending transactions exceed the block gas limit", "ignored", len(txs)-i)
+			break
+		}
+		cfg.TxConfig = statedb.NewTxConfig(common.Hash{}, tx.Hash(), uint(i), cfg.TxConfig.LogIndex)
+		res, err := k.applyPendingTx(ctx, cfg, signer, tx)
+		if err != nil {
+			k.Logger(ctx).Debug("skipped pending transaction", "hash", tx.Hash(), "error", err.Error())
+			continue
+		}
+		cfg.TxConfig.LogIndex += uint(len(res.Logs))
+	}
+
+	return ctx, nil
+}
+
+// applyPendingTx applies a single unconfirmed transaction, the state changes are only written to
+// the context if it's applied successfully. It's also used to replay the transactions of a block.
+func (k *Keeper) applyPendingTx(
+	ctx sdk.Context,
+	cfg *EVMConfig,
+	signer ethtypes.Signer,
+	tx *types.MsgEthereumTx,
+) (*types.MsgEthereumTxResponse, error) {
+	ethTx := tx.AsTransaction()
+	msg, err := tx.ToMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	cfg.SetCodeAuthorizations = tx.SetCodeAuthorizations()
+
+	acct := k.accountKeeper.GetAccount(ctx, msg.From.Bytes())
+	if acct == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s is nil", msg.From)
+	}
+	if nonce := acct.GetSequence(); msg.Nonce != nonce {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce; got %d, expected %d", msg.Nonce, nonce)
+	}
+
+	balance := k.GetEVMDenomBalance(ctx, msg.From)
+	if err := CheckSenderBalance(sdkmath.NewIntFromBigInt(balance), ethTx); err != nil {
+		return nil, err
+	}
+
+	rules := cfg.Rules
+	fees, err := VerifyFee(tx, cfg.Params.EvmDenom, cfg.BaseFee, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai, true)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.DeductTxCostsFromUserBalance(cacheCtx, fees, msg.From); err != nil {
+		return nil, err
+	}
+
+	// the nonce of contract creations is increased during the execution
+	if msg.To != nil {
+		acct = k.accountKeeper.GetAccount(cacheCtx, msg.From.Bytes())
+		if err := acct.SetSequence(msg.Nonce + 1); err != nil {
+			return nil, err
+		}
+		k.accountKeeper.SetAccount(cacheCtx, acct)
+	}
+
+	res, err := k.ApplyMessageWithConfig(cacheCtx, msg, cfg, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.RefundGas(cacheCtx, msg, msg.GasLimit-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, err
+	}
+
+	commit()
+	return res, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+// customContracts creates the custom precompiled contracts enabled by the params and available for the rules,
+// in the order of the params.
+func (k *Keeper) customContracts(ctx sdk.Context, p types.Params, rules params.Rules) []types.StatefulPrecompiledContract {
+	var contracts []types.StatefulPrecompiledContract
+	for _, addr := range p.ActivePrecompileAddresses() {
+		fn, ok := k.customContractFns[addr]
+		if !ok {
+			// the params are validated against the registry, unless the contract is removed from the binary
+			continue
+		}
+		if c := fn(ctx, rules); c != nil {
+			contracts = append(contracts, c)
+		}
+	}
+	return contracts
+}
+
+// ActivePrecompiles returns the addresses of the native and custom precompiled contracts active with the
+// rules, to be left out by the tracers.
+func (k *Keeper) ActivePrecompiles(ctx sdk.Context, rules params.Rules) []common.Address {
+	return k.activePrecompiles(ctx, k.GetParams(ctx), rules)
+}
+
+// activePrecompiles returns the addresses of the native and custom precompiled contracts.
+func (k *Keeper) activePrecompiles(ctx sdk.Context, p types.Params, rules params.Rules) []common.Address {
+	// copy, the native addresses are shared by all callers
+	active := append([]common.Address{}, vm.ActivePrecompiles(rules)...)
+	for _, c := range k.customContracts(ctx, p, rules) {
+		active = append(active, c.Address())
+	}
+	return active
+}
+
+// validatePrecompiles checks the precompiled contracts are registered in the keeper
+func (k *Keeper) validatePrecompiles(p types.Params) error {
+	for _, addr := range p.ActivePrecompileAddresses() {
+		if _, ok := k.customContractFns[addr]; !ok {
+			return errorsmod.Wrapf(types.ErrInvalidPrecompile, "precompile %s is not registered", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// callFrame is the EVM call frame a precompiled contract is executed in.
+type callFrame struct {
+	typ      vm.OpCode
+	caller   common.Address
+	value    *big.Int
+	gas      uint64
+	readonly bool
+}
+
+// callFrames tracks the EVM call frames with the tracing hooks, because go-ethereum only passes the
+// input to the precompiled contracts.
+type callFrames struct {
+	frames []callFrame
+}
+
+// hooks wraps the parent hooks to maintain the call frames, the parent hooks are still called.
+func (f *callFrames) hooks(parent *tracing.Hooks) *tracing.Hooks {
+	var hooks tracing.Hooks
+	if parent != nil {
+		hooks = *parent
+	}
+	onEnter, onExit := hooks.OnEnter, hooks.OnExit
+	hooks.OnEnter = func(depth int, typ byte, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+		op := vm.OpCode(typ)
+		readonly := op == vm.STATICCALL
+		if n := len(f.frames); n > 0 && f.frames[n-1].readonly {
+			readonly = true
+		}
+		f.frames = append(f.frames, callFrame{
+			typ:      op,
+			caller:   from,
+			value:    value,
+			gas:      gas,
+			readonly: readonly,
+		})
+		if onEnter != nil {
+			onEnter(depth, typ, from, to, input, gas, value)
+		}
+	}
+	hooks.OnExit = func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+		if n := len(f.frames); n > 0 {
+			f.frames = f.frames[:n-1]
+		}
+		if onExit != nil {
+			onExit(depth, output, gasUsed, err, reverted)
+		}
+	}
+	return &hooks
+}
+
+// current returns the innermost call frame.
+func (f *callFrames) current() (callFrame, bool) {
+	if len(f.frames) == 0 {
+		return callFrame{}, false
+	}
+	return f.frames[len(f.frames)-1], true
+}
+
+// statefulPrecompile adapts a StatefulPrecompiledContract to the go-ethereum PrecompiledContract interface.
+//
+// go-ethereum deducts RequiredGas from the call frame before Run, and doesn't let Run return the gas left. So the
+// contract is executed in RequiredGas with the gas of the call frame left after its static cost, and the gas it
+// consumes with contract.UseGas is required on top of the static cost. The call frame is charged before Run
+// returns the output, so gasleft() is right in the caller, and the state changes of a call which fails are
+// reverted by the EVM with the snapshot of its frame.
+type statefulPrecompile struct {
+	contract types.StatefulPrecompiledContract
+	frames   *callFrames
+	evm      *vm.EVM
+	// result is the output of the execution in RequiredGas, returned by the next Run
+	result *precompileResult
+}
+
+type precompileResult struct {
+	ret []byte
+	err error
+}
+
+var _ vm.PrecompiledContract = (*statefulPrecompile)(nil)
+
+func (p *statefulPrecompile) RequiredGas(input []byte) uint64 {
+	static := p.contract.RequiredGas(input)
+	p.result = nil
+	frame, ok := p.frames.current()
+	if !ok {
+		p.result = &precompileResult{err: errors.New("precompiled contract called outside of a call frame")}
+		return static
+	}
+	if frame.gas < static {
+		// the EVM fails the call frame without calling Run
+		return static
+	}
+	gas := frame.gas - static
+	contract, err := p.newContract(frame, input, gas)
+	if err != nil {
+		p.result = &precompileResult{err: err}
+		return static
+	}
+	ret, err := p.contract.Run(p.evm, contract, frame.readonly)
+	p.result = &precompileResult{ret: ret, err: err}
+	return static + gas - contract.Gas
+}
+
+func (p *statefulPrecompile) Run([]byte) ([]byte, error) {
+	res := p.result
+	p.result = nil
+	if res == nil {
+		return nil, errors.New("precompiled contract run without its required gas")
+	}
+	return res.ret, res.err
+}
+
+func (p *statefulPrecompile) newContract(frame callFrame, input []byte, gas uint64) (*vm.Contract, error) {
+	switch frame.typ {
+	case vm.DELEGATECALL, vm.CALLCODE:
+		// the precompiled contracts authenticate the caller, it can't run in the context of another contract
+		return nil, errors.New("precompiled contract can't be delegate called")
+	}
+
+	value := new(uint256.Int)
+	if frame.value != nil {
+		value.SetFromBig(frame.value)
+	}
+	contract := vm.NewContract(vm.AccountRef(frame.caller), vm.AccountRef(p.contract.Address()), value, gas)
+	contract.Input = input
+	return contract, nil
+}
@@ -0,0 +1,303 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Helios-Chain-Labs/ethermint/precompiles"
+	"github.com/Helios-Chain-Labs/ethermint/precompiles/bindings/cosmos/precompile/bank"
+	"github.com/Helios-Chain-Labs/ethermint/precompiles/bindings/cosmos/precompile/relayer"
+	"github.com/Helios-Chain-Labs/ethermint/tests"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+var (
+	// proxyCallCode forwards the calldata to the bank precompile with CALL and returns the success flag.
+	proxyCallCode = common.FromHex("36600060003760006000366000600060645af160005260206000f3")
+	// proxyDelegateCallCode forwards the calldata to the bank precompile with DELEGATECALL and returns the
+	// success flag.
+	proxyDelegateCallCode = common.FromHex("3660006000376000600036600060645af460005260206000f3")
+	// proxyRelayerCallCode forwards the calldata to the relayer precompile with CALL and returns the success flag.
+	proxyRelayerCallCode = common.FromHex("36600060003760006000366000600060655af160005260206000f3")
+	// proxyGasCallCode calls the relayer precompile with the gas of the first word of the calldata and the rest
+	// of the calldata as input, it returns the success flag and the gas used by the call measured with gasleft().
+	proxyGasCallCode = common.FromHex(
+		"36602090038060206000375a906000600082600060006065600035f190506000525a900360205260406000f3",
+	)
+)
+
+func (suite *KeeperTestSuite) setActivePrecompiles(addrs ...common.Address) error {
+	params := suite.App.EvmKeeper.GetParams(suite.Ctx)
+	params.ActivePrecompiles = nil
+	for _, addr := range addrs {
+		params.ActivePrecompiles = append(params.ActivePrecompiles, addr.Hex())
+	}
+	if err := suite.App.EvmKeeper.SetParams(suite.Ctx, params); err != nil {
+		return err
+	}
+	suite.App.EvmKeeper.RemoveParamsCache(suite.Ctx)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestActivePrecompiles() {
+	err := suite.setActivePrecompiles(common.BytesToAddress([]byte{200}))
+	suite.Require().ErrorIs(err, types.ErrInvalidPrecompile)
+	err = suite.setActivePrecompiles(precompiles.BankContractAddress, precompiles.BankContractAddress)
+	suite.Require().ErrorContains(err, "duplicate precompile")
+	suite.Require().NoError(suite.setActivePrecompiles(precompiles.BankContractAddress, precompiles.ICAContractAddress))
+}
+
+func (suite *KeeperTestSuite) TestBankPrecompile() {
+	bankABI, err := bank.BankModuleMetaData.GetAbi()
+	suite.Require().NoError(err)
+	recipient := tests.GenerateAddress()
+	amount := big.NewInt(100)
+	input, err := bankABI.Pack(precompiles.MintMethodName, recipient, amount)
+	suite.Require().NoError(err)
+
+	proxy := tests.GenerateAddress()
+	delegateProxy := tests.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(proxy, proxyCallCode)
+	vmdb.SetCode(delegateProxy, proxyDelegateCallCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	balance := func(minter common.Address) *big.Int {
+		return suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.AccAddress(recipient.Bytes()), precompiles.EVMDenom(minter)).Amount.BigInt()
+	}
+	call := func(to common.Address) *types.MsgEthereumTxResponse {
+		res, err := suite.App.EvmKeeper.CallEVM(suite.Ctx, suite.Address, &to, nil, input, 1000000)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// not reachable until it's enabled
+	res := call(precompiles.BankContractAddress)
+	suite.Require().Empty(res.Ret)
+	suite.Require().Zero(balance(suite.Address).Sign())
+
+	suite.Require().NoError(suite.setActivePrecompiles(precompiles.BankContractAddress))
+	res = call(precompiles.BankContractAddress)
+	suite.Require().Equal(common.LeftPadBytes([]byte{1}, 32), res.Ret)
+	suite.Require().Equal(amount, balance(suite.Address))
+	// the gas required by the precompile is charged
+	suite.Require().Greater(res.GasUsed, uint64(200000))
+
+	// the caller of the call frame is the proxy contract
+	res = call(proxy)
+	suite.Require().Equal(common.LeftPadBytes([]byte{1}, 32), res.Ret)
+	suite.Require().Equal(amount, balance(proxy))
+
+	// the precompile can't run in the context of the proxy contract
+	res = call(delegateProxy)
+	suite.Require().Equal(make([]byte, 32), res.Ret)
+	suite.Require().Zero(balance(delegateProxy).Sign())
+
+	// disabled by the params again
+	suite.Require().NoError(suite.setActivePrecompiles())
+	res = call(precompiles.BankContractAddress)
+	suite.Require().Empty(res.Ret)
+	suite.Require().Equal(amount, balance(suite.Address))
+}
+
+func (suite *KeeperTestSuite) TestAccessListTracerPrecompiles() {
+	bankABI, err := bank.BankModuleMetaData.GetAbi()
+	suite.Require().NoError(err)
+	input, err := bankABI.Pack(precompiles.MintMethodName, tests.GenerateAddress(), big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.setActivePrecompiles(precompiles.BankContractAddress))
+
+	proxy := tests.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(proxy, proxyCallCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	cfg, err := suite.App.EvmKeeper.EVMConfig(suite.Ctx, suite.App.EvmKeeper.ChainID(), common.Hash{})
+	suite.Require().NoError(err)
+	msg := &core.Message{
+		To:               &proxy,
+		From:             suite.Address,
+		Nonce:            suite.App.EvmKeeper.GetNonce(suite.Ctx, suite.Address),
+		Value:            new(big.Int),
+		GasLimit:         1000000,
+		GasPrice:         new(big.Int),
+		GasFeeCap:        new(big.Int),
+		GasTipCap:        new(big.Int),
+		Data:             input,
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+
+	// the custom precompiled contracts are left out of the access list, like the native ones
+	precompileAddrs := suite.App.EvmKeeper.ActivePrecompiles(suite.Ctx, cfg.Rules)
+	suite.Require().Contains(precompileAddrs, precompiles.BankContractAddress)
+	tracer := types.NewTracer(types.TracerAccessList, msg, precompileAddrs)
+	res, err := suite.App.EvmKeeper.ApplyMessage(suite.Ctx, msg, tracer, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.LeftPadBytes([]byte{1}, 32), res.Ret)
+
+	result, err := tracer.GetResult()
+	suite.Require().NoError(err)
+	var accessList ethtypes.AccessList
+	suite.Require().NoError(json.Unmarshal(result, &accessList))
+	// the proxy is the recipient and the bank precompile is the only other account accessed
+	suite.Require().Empty(accessList)
+}
+
+func (suite *KeeperTestSuite) TestPrecompileActivationFork() {
+	bankABI, err := bank.BankModuleMetaData.GetAbi()
+	suite.Require().NoError(err)
+	recipient := tests.GenerateAddress()
+	input, err := bankABI.Pack(precompiles.MintMethodName, recipient, big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.setActivePrecompiles(precompiles.BankContractAddress))
+
+	setShanghaiTime := func(t int64) {
+		params := suite.App.EvmKeeper.GetParams(suite.Ctx)
+		shanghaiTime := sdkmath.NewInt(t)
+		params.ChainConfig.ShanghaiTime = &shanghaiTime
+		suite.Require().NoError(suite.App.EvmKeeper.SetParams(suite.Ctx, params))
+		suite.App.EvmKeeper.RemoveParamsCache(suite.Ctx)
+	}
+	call := func() []byte {
+		to := precompiles.BankContractAddress
+		res, err := suite.App.EvmKeeper.CallEVM(suite.Ctx, suite.Address, &to, nil, input, 1000000)
+		suite.Require().NoError(err)
+		return res.Ret
+	}
+
+	// enabled by the params, but not before its fork
+	setShanghaiTime(suite.Ctx.BlockTime().Unix() + 3600)
+	suite.Require().Empty(call())
+
+	setShanghaiTime(0)
+	suite.Require().Equal(common.LeftPadBytes([]byte{1}, 32), call())
+}
+
+// createClientInput returns the relayer precompile input of a MsgCreateClient signed by signer.
+func (suite *KeeperTestSuite) createClientInput(signer common.Address) []byte {
+	relayerABI, err := relayer.RelayerFunctionsMetaData.GetAbi()
+	suite.Require().NoError(err)
+	height := clienttypes.NewHeight(1, 10)
+	clientState := ibctm.NewClientState(
+		"counterparty-1", ibctm.DefaultTrustLevel, time.Hour, 2*time.Hour, 10*time.Second,
+		height, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"},
+	)
+	consensusState := ibctm.NewConsensusState(
+		suite.Ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), make([]byte, 32),
+	)
+	msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, sdk.AccAddress(signer.Bytes()).String())
+	suite.Require().NoError(err)
+	bz, err := suite.App.AppCodec().Marshal(msg)
+	suite.Require().NoError(err)
+	input, err := relayerABI.Pack(precompiles.CreateClientMethodName, bz)
+	suite.Require().NoError(err)
+	return input
+}
+
+func (suite *KeeperTestSuite) clientFound() bool {
+	_, found := suite.App.IBCKeeper.ClientKeeper.GetClientState(suite.Ctx, "07-tendermint-0")
+	return found
+}
+
+func (suite *KeeperTestSuite) TestPrecompileDynamicGas() {
+	input := suite.createClientInput(suite.Address)
+
+	suite.Require().NoError(suite.setActivePrecompiles(precompiles.RelayerContractAddress))
+	intrinsicGas, err := core.IntrinsicGas(input, nil, false, true, true, true)
+	suite.Require().NoError(err)
+	staticGas := uint64(len(input)) * storetypes.KVGasConfig().WriteCostPerByte
+
+	// the static cost is covered, but not the store gas consumed by the message
+	to := precompiles.RelayerContractAddress
+	_, err = suite.App.EvmKeeper.CallEVM(suite.Ctx, suite.Address, &to, nil, input, intrinsicGas+staticGas+1000)
+	suite.Require().ErrorContains(err, "out of gas")
+	suite.Require().False(suite.clientFound())
+
+	res, err := suite.App.EvmKeeper.CallEVM(suite.Ctx, suite.Address, &to, nil, input, 2000000)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.clientFound())
+	// the store gas consumed by the message is charged on top of the static cost
+	suite.Require().Greater(res.GasUsed, intrinsicGas+staticGas+1000)
+}
+
+func (suite *KeeperTestSuite) TestPrecompileGasInCallFrame() {
+	proxy := tests.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(proxy, proxyGasCallCode)
+	suite.Require().NoError(vmdb.Commit())
+	suite.Require().NoError(suite.setActivePrecompiles(precompiles.RelayerContractAddress))
+
+	input := suite.createClientInput(proxy)
+	staticGas := uint64(len(input)) * storetypes.KVGasConfig().WriteCostPerByte
+	call := func(gas uint64) (bool, uint64) {
+		data := append(common.BigToHash(new(big.Int).SetUint64(gas)).Bytes(), input...)
+		res, err := suite.App.EvmKeeper.CallEVM(suite.Ctx, suite.Address, &proxy, nil, data, 3000000)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Require().Len(res.Ret, 64)
+		return res.Ret[31] == 1, new(big.Int).SetBytes(res.Ret[32:]).Uint64()
+	}
+
+	// the call runs out of gas in its own frame, the caller carries on
+	success, _ := call(staticGas + 1000)
+	suite.Require().False(success)
+	suite.Require().False(suite.clientFound())
+
+	// the store gas consumed by the message is deducted from the caller before the call returns
+	success, gasUsed := call(2000000)
+	suite.Require().True(success)
+	suite.Require().True(suite.clientFound())
+	suite.Require().Greater(gasUsed, staticGas+10000)
+}
+
+func (suite *KeeperTestSuite) TestRelayICAAcknowledgement() {
+	relayerABI, err := relayer.RelayerFunctionsMetaData.GetAbi()
+	suite.Require().NoError(err)
+	proxy := tests.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(proxy, proxyRelayerCallCode)
+	suite.Require().NoError(vmdb.Commit())
+	suite.Require().NoError(suite.setActivePrecompiles(precompiles.RelayerContractAddress))
+
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1, portID, "channel-0", icatypes.HostPortID, "channel-0",
+		clienttypes.NewHeight(1, 100), 0,
+	)
+	ack := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	input := func(signer common.Address) []byte {
+		msg := channeltypes.NewMsgAcknowledgement(
+			packet, ack, []byte("proof"), clienttypes.NewHeight(1, 10), sdk.AccAddress(signer.Bytes()).String(),
+		)
+		bz, err := suite.App.AppCodec().Marshal(msg)
+		suite.Require().NoError(err)
+		input, err := relayerABI.Pack(precompiles.AcknowledgementMethodName, bz)
+		suite.Require().NoError(err)
+		return input
+	}
+
+	// the callback of the owner contract can't run in a nested EVM
+	res, err := suite.App.EvmKeeper.CallEVM(suite.Ctx, suite.Address, &proxy, nil, input(proxy), 1000000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(make([]byte, 32), res.Ret)
+
+	to := precompiles.RelayerContractAddress
+	_, err = suite.App.EvmKeeper.CallEVM(suite.Ctx, suite.Address, &to, nil, input(suite.Address), 1000000)
+	suite.Require().ErrorContains(err, "can't be relayed by a contract")
+}
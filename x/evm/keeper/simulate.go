@@ -0,0 +1,444 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+
+	rpctypes "github.com/Helios-Chain-Labs/ethermint/rpc/types"
+	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
+	cosmostracing "github.com/Helios-Chain-Labs/ethermint/x/evm/tracing"
+	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks of a eth_simulateV1 request, same as geth
+	maxSimulateBlocks = 256
+	// simulateTimestampIncrement is the default time between the simulated blocks, same as geth
+	simulateTimestampIncrement = 12
+	// simulateErrCodeVMError is the json-rpc error code of the calls failed with a vm error other than revert
+	simulateErrCodeVMError = -32015
+)
+
+var (
+	// transferLogAddress is the address of the ERC-7528 logs emitted for the native transfers
+	transferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	transferTopic      = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// simulator executes the simulated blocks of eth_simulateV1 on top of the state of the requested block.
+type simulator struct {
+	keeper *Keeper
+	// cfg is the evm config of the requested block
+	cfg    *EVMConfig
+	opts   *rpctypes.SimOpts
+	gasCap uint64
+}
+
+// execute runs the simulated blocks in order on a branch of the state, so the calls see the state changes of the
+// previous ones, and returns the RPC representations of the blocks.
+func (s *simulator) execute(ctx sdk.Context) ([]map[string]interface{}, error) {
+	if len(s.opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, fmt.Errorf("too many blocks: %d > %d", len(s.opts.BlockStateCalls), maxSimulateBlocks)
+	}
+	ctx, _ = ctx.CacheContext()
+
+	var (
+		number     = s.cfg.BlockNumber
+		timestamp  = s.cfg.BlockTime
+		parentHash = common.BytesToHash(ctx.HeaderHash())
+		blocks     = make([]map[string]interface{}, 0, len(s.opts.BlockStateCalls))
+	)
+	for i, block := range s.opts.BlockStateCalls {
+		overrides := rpctypes.BlockOverrides{}
+		if block.BlockOverrides != nil {
+			overrides = *block.BlockOverrides
+		}
+
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(new(big.Int).Add(number, common.Big1))
+		} else if overrides.Number.ToInt().Cmp(number) <= 0 {
+			return nil, fmt.Errorf("block %d: block numbers must be in order: %s <= %s", i, overrides.Number.ToInt(), number)
+		}
+		if !overrides.Number.ToInt().IsInt64() {
+			return nil, fmt.Errorf("block %d: block number %s overflows", i, overrides.Number.ToInt())
+		}
+		if overrides.Time == nil {
+			t := hexutil.Uint64(timestamp + simulateTimestampIncrement)
+			overrides.Time = &t
+		} else if uint64(*overrides.Time) <= timestamp {
+			return nil, fmt.Errorf("block %d: block timestamps must be in order: %d <= %d", i, *overrides.Time, timestamp)
+		}
+
+		result, hash, err := s.executeBlock(ctx, block, &overrides, parentHash)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		blocks = append(blocks, result)
+		number, timestamp, parentHash = overrides.Number.ToInt(), uint64(*overrides.Time), hash
+	}
+	return blocks, nil
+}
+
+// executeBlock runs the calls of a simulated block, the number and time of the block overrides must be set.
+func (s *simulator) executeBlock(
+	ctx sdk.Context,
+	block rpctypes.SimBlock,
+	overrides *rpctypes.BlockOverrides,
+	parentHash common.Hash,
+) (map[string]interface{}, common.Hash, error) {
+	number, timestamp := overrides.Number.ToInt(), uint64(*overrides.Time)
+	ctx = ctx.WithBlockHeight(number.Int64()).WithBlockTime(time.Unix(int64(timestamp), 0).UTC()) //#nosec G115 -- checked above
+
+	blockCfg := *s.cfg.EVMBlockConfig
+	blockCfg.BlockNumber = number
+	blockCfg.BlockTime = timestamp
+	blockCfg.Rules = blockCfg.ChainConfig.Rules(number, blockCfg.ChainConfig.MergeNetsplitBlock != nil, timestamp)
+	// the calls default to the gas left in the block, report the gas used by the execution only
+	blockCfg.FeeMarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+	if overrides.Coinbase != nil {
+		blockCfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		blockCfg.BaseFee = overrides.BaseFee.ToInt()
+	} else if !s.opts.Validation && blockCfg.BaseFee != nil {
+		// like geth, the base fee is zero when not validating, so the calls don't need to set the gas price
+		blockCfg.BaseFee = new(big.Int)
+	}
+	cfg := &EVMConfig{
+		EVMBlockConfig: &blockCfg,
+		TxConfig:       statedb.NewEmptyTxConfig(common.Hash{}),
+		Tracer:         s.cfg.Tracer,
+		BlockOverrides: overrides,
+	}
+
+	gasLimit := ethermint.BlockGasLimit(ctx)
+	if overrides.GasLimit != nil {
+		gasLimit = uint64(*overrides.GasLimit)
+	}
+	if gasLimit == 0 {
+		gasLimit = s.gasCap
+	}
+	if gasLimit == 0 {
+		gasLimit = math.MaxUint64 / 2
+	}
+
+	if block.StateOverrides != nil {
+		stateDB := statedb.NewWithParams(ctx, s.keeper, cfg.TxConfig, cfg.Params.EvmDenom)
+		if err := block.StateOverrides.Apply(stateDB); err != nil {
+			return nil, common.Hash{}, errorsmod.Wrap(types.ErrConfigOverrides, err.Error())
+		}
+		if err := stateDB.Commit(); err != nil {
+			return nil, common.Hash{}, err
+		}
+	}
+
+	var (
+		gasUsed  uint64
+		txs      = make([]*ethtypes.Transaction, 0, len(block.Calls))
+		senders  = make([]common.Address, 0, len(block.Calls))
+		receipts = make([]*ethtypes.Receipt, 0, len(block.Calls))
+		calls    = make([]rpctypes.SimCallResult, 0, len(block.Calls))
+	)
+	for i, args := range block.Calls {
+		tx, call, err := s.executeCall(ctx, cfg, args, uint(i), gasLimit-gasUsed)
+		if err != nil {
+			return nil, common.Hash{}, fmt.Errorf("call %d: %w", i, err)
+		}
+		gasUsed += uint64(call.GasUsed)
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            uint64(call.Status),
+			CumulativeGasUsed: gasUsed,
+			Logs:              call.Logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           uint64(call.GasUsed),
+		}
+		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+		txs = append(txs, tx)
+		senders = append(senders, args.GetFrom())
+		receipts = append(receipts, receipt)
+		calls = append(calls, call)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   blockCfg.CoinBase,
+		Difficulty: big.NewInt(0),
+		Number:     number,
+		GasLimit:   gasLimit,
+		GasUsed:    gasUsed,
+		Time:       timestamp,
+		BaseFee:    blockCfg.BaseFee,
+	}
+	if overrides.Random != nil {
+		header.MixDigest = *overrides.Random
+	}
+	ethBlock := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	hash := ethBlock.Hash()
+
+	// the block hash is only known after the execution
+	var logIndex uint
+	for i, call := range calls {
+		for _, log := range call.Logs {
+			log.BlockHash = hash
+			log.BlockNumber = number.Uint64()
+			log.TxHash = txs[i].Hash()
+			log.TxIndex = uint(i)
+			log.Index = logIndex
+			logIndex++
+		}
+	}
+
+	transactions := make([]interface{}, len(txs))
+	for i, tx := range txs {
+		if s.opts.ReturnFullTransactions {
+			transactions[i] = rpctypes.NewRPCTransactionWithSender(
+				tx, senders[i], hash, number.Uint64(), uint64(i), header.BaseFee, blockCfg.ChainConfig.ChainID,
+			)
+		} else {
+			transactions[i] = tx.Hash()
+		}
+	}
+
+	result := map[string]interface{}{
+		"number":           (*hexutil.Big)(number),
+		"hash":             hash,
+		"parentHash":       parentHash,
+		"nonce":            ethtypes.BlockNonce{},
+		"mixHash":          header.MixDigest,
+		"sha3Uncles":       ethtypes.EmptyUncleHash,
+		"logsBloom":        ethBlock.Bloom(),
+		"stateRoot":        common.Hash{},
+		"miner":            header.Coinbase,
+		"difficulty":       (*hexutil.Big)(header.Difficulty),
+		"extraData":        hexutil.Bytes{},
+		"size":             hexutil.Uint64(ethBlock.Size()),
+		"gasLimit":         hexutil.Uint64(gasLimit),
+		"gasUsed":          hexutil.Uint64(gasUsed),
+		"timestamp":        hexutil.Uint64(timestamp),
+		"transactionsRoot": ethBlock.TxHash(),
+		"receiptsRoot":     ethBlock.ReceiptHash(),
+		"uncles":           []common.Hash{},
+		"transactions":     transactions,
+		"calls":            calls,
+	}
+	if header.BaseFee != nil {
+		result["baseFeePerGas"] = (*hexutil.Big)(header.BaseFee)
+	}
+	return result, hash, nil
+}
+
+// executeCall runs a simulated call with the gas left in the block, the sender nonce is bumped like a transaction,
+// and the fee is charged in validation mode.
+func (s *simulator) executeCall(
+	ctx sdk.Context,
+	cfg *EVMConfig,
+	args types.TransactionArgs,
+	index uint,
+	gasLeft uint64,
+) (*ethtypes.Transaction, rpctypes.SimCallResult, error) {
+	var result rpctypes.SimCallResult
+	from := args.GetFrom()
+	stateDB := statedb.NewWithParams(ctx, s.keeper, cfg.TxConfig, cfg.Params.EvmDenom)
+
+	nonce := stateDB.GetNonce(from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	} else if s.opts.Validation && uint64(*args.Nonce) != nonce {
+		err := core.ErrNonceTooLow
+		if uint64(*args.Nonce) > nonce {
+			err = core.ErrNonceTooHigh
+		}
+		return nil, result, fmt.Errorf("%w: address %s, tx: %d state: %d", err, from.Hex(), *args.Nonce, nonce)
+	}
+	if args.Gas == nil {
+		gas := gasLeft
+		if s.gasCap != 0 && s.gasCap < gas {
+			gas = s.gasCap
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	} else if uint64(*args.Gas) > gasLeft {
+		return nil, result, fmt.Errorf("block gas limit reached: %d > %d", *args.Gas, gasLeft)
+	}
+
+	msg, err := args.ToMessage(s.gasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, result, err
+	}
+	if s.opts.Validation {
+		if cfg.BaseFee != nil && msg.GasFeeCap.Cmp(cfg.BaseFee) < 0 {
+			return nil, result, fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s",
+				core.ErrFeeCapTooLow, from.Hex(), msg.GasFeeCap, cfg.BaseFee)
+		}
+		cost := new(big.Int).Mul(msg.GasFeeCap, new(big.Int).SetUint64(msg.GasLimit))
+		cost.Add(cost, msg.Value)
+		if balance := stateDB.GetBalance(from).ToBig(); balance.Cmp(cost) < 0 {
+			return nil, result, fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, from.Hex(), balance, cost)
+		}
+	}
+
+	var txData ethtypes.TxData
+	if args.GasPrice != nil {
+		txData = &ethtypes.LegacyTx{
+			Nonce:    msg.Nonce,
+			GasPrice: msg.GasPrice,
+			Gas:      msg.GasLimit,
+			To:       msg.To,
+			Value:    msg.Value,
+			Data:     msg.Data,
+		}
+	} else {
+		txData = &ethtypes.DynamicFeeTx{
+			ChainID:    cfg.ChainConfig.ChainID,
+			Nonce:      msg.Nonce,
+			GasTipCap:  msg.GasTipCap,
+			GasFeeCap:  msg.GasFeeCap,
+			Gas:        msg.GasLimit,
+			To:         msg.To,
+			Value:      msg.Value,
+			Data:       msg.Data,
+			AccessList: msg.AccessList,
+		}
+	}
+	tx := ethtypes.NewTx(txData)
+
+	callCfg := *cfg
+	callCfg.TxConfig = statedb.NewTxConfig(common.Hash{}, tx.Hash(), index, 0)
+	callCfg.SetCodeAuthorizations = args.AuthorizationList
+	var tracer *transferTracer
+	if s.opts.TraceTransfers {
+		tracer = &transferTracer{}
+		callCfg.Tracer = tracer.hooks()
+	}
+	res, err := s.keeper.ApplyMessageWithConfig(ctx, msg, &callCfg, true)
+	if err != nil {
+		return nil, result, err
+	}
+
+	stateDB = statedb.NewWithParams(ctx, s.keeper, callCfg.TxConfig, cfg.Params.EvmDenom)
+	if msg.To != nil {
+		// the nonce of contract creation is already bumped by the evm
+		stateDB.SetNonce(from, msg.Nonce+1)
+	}
+	if s.opts.Validation {
+		fee := new(big.Int).Mul(msg.GasPrice, new(big.Int).SetUint64(res.GasUsed))
+		stateDB.SubBalance(from, uint256.MustFromBig(fee), tracing.BalanceDecreaseGasBuy)
+	}
+	if err := stateDB.Commit(); err != nil {
+		return nil, result, err
+	}
+
+	result = rpctypes.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        types.LogsToEthereum(res.Logs),
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if tracer != nil {
+		result.Logs = tracer.logs
+	}
+	if result.Logs == nil {
+		result.Logs = []*ethtypes.Log{}
+	}
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := types.NewExecErrorWithReason(res.Ret)
+			result.Error = &rpctypes.SimCallError{
+				Message: revertErr.Error(),
+				Code:    revertErr.ErrorCode(),
+				Data:    hexutil.Encode(res.Ret),
+			}
+		} else {
+			result.Error = &rpctypes.SimCallError{
+				Message: res.VmError,
+				Code:    simulateErrCodeVMError,
+			}
+		}
+	}
+	return tx, result, nil
+}
+
+// transferTracer collects the logs of a call along with the ERC-7528 logs of the native transfers,
+// the logs of the reverted call frames are dropped.
+type transferTracer struct {
+	frames [][]*ethtypes.Log
+	logs   []*ethtypes.Log
+}
+
+func (t *transferTracer) hooks() *cosmostracing.Hooks {
+	return &cosmostracing.Hooks{
+		Hooks: &tracing.Hooks{
+			OnEnter: t.onEnter,
+			OnExit:  t.onExit,
+			OnLog:   t.onLog,
+		},
+	}
+}
+
+func (t *transferTracer) onEnter(_ int, typ byte, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, nil)
+	if vm.OpCode(typ) != vm.DELEGATECALL && value != nil && value.Sign() > 0 {
+		t.onLog(&ethtypes.Log{
+			Address: transferLogAddress,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value).Bytes(),
+		})
+	}
+}
+
+func (t *transferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	last := len(t.frames) - 1
+	logs := t.frames[last]
+	t.frames = t.frames[:last]
+	if reverted {
+		return
+	}
+	if last == 0 {
+		t.logs = append(t.logs, logs...)
+	} else {
+		t.frames[last-1] = append(t.frames[last-1], logs...)
+	}
+}
+
+func (t *transferTracer) onLog(log *ethtypes.Log) {
+	if len(t.frames) == 0 {
+		t.logs = append(t.logs, log)
+		return
+	}
+	last := len(t.frames) - 1
+	t.frames[last] = append(t.frames[last], log)
+}
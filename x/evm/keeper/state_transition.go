@@ -16,11 +16,9 @@
 package keeper
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
-	"sort"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/holiman/uint256"
@@ -30,9 +28,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethermint "github.com/Helios-Chain-Labs/ethermint/types"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/statedb"
+	cosmostracing "github.com/Helios-Chain-Labs/ethermint/x/evm/tracing"
 	"github.com/Helios-Chain-Labs/ethermint/x/evm/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	vm "github.com/ethereum/go-ethereum/core/vm"
@@ -67,29 +67,51 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      cfg.Random,
 	}
+	if cfg.Rules.IsCancun {
+		// blobs are not kept on chain, so there is no blob gas market and
+		// the blob base fee stays at its minimum
+		blockCtx.BlobBaseFee = eip4844.CalcBlobFee(0)
+	}
 	if cfg.BlockOverrides != nil {
 		cfg.BlockOverrides.Apply(&blockCtx)
 	}
 	txCtx := core.NewEVMTxContext(msg)
 
 	vmConfig := k.VMConfig(ctx, cfg)
-	contracts := make(map[common.Address]vm.PrecompiledContract)
-	active := make([]common.Address, 0)
-	for addr, c := range vm.ActivePrecompiledContracts(cfg.Rules) {
-		contracts[addr] = c
-		active = append(active, addr)
-	}
-	// for _, fn := range k.customContractFns {
-	// 	c := fn(ctx, cfg.Rules)
-	// 	addr := c.Address()
-	// 	contracts[addr] = c
-	// 	active = append(active, addr)
-	// }
-	sort.SliceStable(active, func(i, j int) bool {
-		return bytes.Compare(active[i].Bytes(), active[j].Bytes()) < 0
-	})
+	// the calls to the EIP-7702 delegated accounts execute the code of the delegation target
+	db, delegation := stateDB.(*statedb.StateDB)
+	delegation = delegation && cfg.Rules.IsPrague
+	if delegation {
+		vmConfig.Tracer = delegationHooks(db, vmConfig.Tracer)
+	}
+
+	custom := k.customContracts(ctx, cfg.Params, cfg.Rules)
+	if len(custom) == 0 {
+		evm := vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+		if delegation {
+			enableDelegationGas(evm)
+		}
+		return evm
+	}
+
+	// the custom contracts need the call frame they are executed in
+	frames := &callFrames{}
+	vmConfig.Tracer = frames.hooks(vmConfig.Tracer)
+	contracts := vm.ActivePrecompiledContracts(cfg.Rules)
+	adapters := make([]*statefulPrecompile, 0, len(custom))
+	for _, c := range custom {
+		adapter := &statefulPrecompile{contract: c, frames: frames}
+		contracts[c.Address()] = adapter
+		adapters = append(adapters, adapter)
+	}
 	evm := vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
-	// evm.WithPrecompiles(contracts, active)
+	evm.SetPrecompiles(contracts)
+	for _, adapter := range adapters {
+		adapter.evm = evm
+	}
+	if delegation {
+		enableDelegationGas(evm)
+	}
 	return evm
 }
 
@@ -167,11 +189,11 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
-	ethTx := msgEth.AsTransaction()
-	cfg, err := k.EVMConfig(ctx, k.eip155ChainID, ethTx.Hash())
+	cfg, err := k.EVMConfig(ctx, k.eip155ChainID, msgEth.Hash())
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
+	cfg.SetCodeAuthorizations = msgEth.SetCodeAuthorizations()
 
 	msg := msgEth.AsMessage(cfg.BaseFee)
 	// snapshot to contain the tx processing and post-processing in same scope
@@ -218,7 +240,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	receipt := &ethtypes.Receipt{
-		Type:            ethTx.Type(),
+		Type:            msgEth.TxType(),
 		PostState:       nil, // TODO: intermediate state root
 		Logs:            logs,
 		TxHash:          cfg.TxConfig.TxHash,
@@ -274,16 +296,56 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	return res, nil
 }
 
-// ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
+// ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig, the tracer replaces the one of the keeper if
+// it's not nil.
 func (k *Keeper) ApplyMessage(ctx sdk.Context, msg *core.Message, tracer *tracers.Tracer, commit bool) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, k.eip155ChainID, common.Hash{})
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
+	if tracer != nil && tracer.Hooks != nil {
+		cfg.Tracer = &cosmostracing.Hooks{Hooks: tracer.Hooks}
+	}
 
 	return k.ApplyMessageWithConfig(ctx, msg, cfg, commit)
 }
 
+// CallEVM executes a message from the native modules, the state changes are committed, the caller is expected to
+// use a cache context if it needs to discard them. A reverted execution returns an error.
+func (k *Keeper) CallEVM(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	value *big.Int,
+	data []byte,
+	gasLimit uint64,
+) (*types.MsgEthereumTxResponse, error) {
+	if value == nil {
+		value = new(big.Int)
+	}
+	msg := &core.Message{
+		To:               contract,
+		From:             from,
+		Nonce:            k.GetNonce(ctx, from),
+		Value:            value,
+		GasLimit:         gasLimit,
+		GasPrice:         new(big.Int),
+		GasFeeCap:        new(big.Int),
+		GasTipCap:        new(big.Int),
+		Data:             data,
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	res, err := k.ApplyMessage(ctx, msg, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if res.Failed() {
+		return res, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+	return res, nil
+}
+
 // ApplyMessageWithConfig computes the new state by applying the given message against the existing state.
 // If the message fails, the VM execution error with the reason will be returned to the client
 // and the transaction won't be committed to the store.
@@ -399,6 +461,20 @@ func (k *Keeper) ApplyMessageWithConfig(
 		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
+	if len(cfg.SetCodeAuthorizations) > 0 {
+		if !rules.IsPrague {
+			return nil, errorsmod.Wrap(ethtypes.ErrTxTypeNotSupported, "set code authorizations before prague")
+		}
+		if contractCreation {
+			return nil, errors.New("set code authorizations can't be used for contract creation")
+		}
+		authGas := uint64(len(cfg.SetCodeAuthorizations)) * types.TxAuthEmptyAccountGas
+		if intrinsicGas+authGas < intrinsicGas {
+			return nil, errorsmod.Wrap(core.ErrGasUintOverflow, "apply message")
+		}
+		intrinsicGas += authGas
+	}
+
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
@@ -416,7 +492,20 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// Execute the preparatory steps for state transition which includes:
 	// - prepare accessList(post-berlin)
 	// - reset transient storage(eip 1153)
-	stateDB.Prepare(rules, msg.From, cfg.CoinBase, msg.To, vm.ActivePrecompiles(rules), msg.AccessList)
+	stateDB.Prepare(rules, msg.From, cfg.CoinBase, msg.To, k.activePrecompiles(ctx, cfg.Params, rules), msg.AccessList)
+
+	// Apply the EIP-7702 authorizations, invalid tuples are skipped.
+	for _, auth := range cfg.SetCodeAuthorizations {
+		if err := stateDB.ApplyAuthorization(cfg.ChainConfig.ChainID, auth); err != nil {
+			k.Logger(ctx).Debug("skipped set code authorization", "address", auth.Address, "error", err.Error())
+		}
+	}
+	if rules.IsPrague && !contractCreation {
+		// the delegation target of the recipient is warm
+		if target, ok := stateDB.GetDelegation(*msg.To); ok {
+			stateDB.AddAddressToAccessList(target)
+		}
+	}
 
 	if contractCreation {
 		// Why do we want to set the nonce in the statedb twice here?
@@ -21,6 +21,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -634,7 +635,7 @@ func (suite *StateTransitionTestSuite) TestApplyMessage() {
 	)
 	suite.Require().NoError(err)
 
-	tracer := types.NewTracer("", msg, rules)
+	tracer := types.NewTracer("", msg, suite.App.EvmKeeper.ActivePrecompiles(suite.Ctx, rules))
 
 	res, err := suite.App.EvmKeeper.ApplyMessage(suite.Ctx, msg, tracer, true)
 
@@ -707,6 +708,61 @@ func (suite *StateTransitionTestSuite) TestApplyTransactionWithTracer() {
 	suite.Require().True(onTxEndHookCalled)
 }
 
+func (suite *StateTransitionTestSuite) TestApplyTransactionSetCode() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(key.PublicKey)
+	target := tests.GenerateAddress()
+	chainID := suite.App.EvmKeeper.ChainID()
+
+	// ADDRESS PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	vmdb := suite.StateDB()
+	vmdb.SetCode(target, common.FromHex("0x3060005260206000f3"))
+	suite.Require().NoError(vmdb.Commit())
+
+	keeperParams := suite.App.EvmKeeper.GetParams(suite.Ctx)
+	zero := sdkmath.ZeroInt()
+	keeperParams.ChainConfig.CancunTime = &zero
+	keeperParams.ChainConfig.PragueTime = &zero
+	suite.Require().NoError(suite.App.EvmKeeper.SetParams(suite.Ctx, keeperParams))
+	suite.App.EvmKeeper.RemoveParamsCache(suite.Ctx)
+
+	auth, err := types.SignSetCode(key, types.SetCodeAuthorization{
+		ChainID: hexutil.Big(*chainID),
+		Address: target,
+		Nonce:   0,
+	})
+	suite.Require().NoError(err)
+
+	msg := types.NewSetCodeTx(&types.SetCodeTx{
+		ChainID:   chainID,
+		Nonce:     suite.StateDB().GetNonce(suite.Address),
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(0),
+		Gas:       100000,
+		To:        authority,
+		Value:     big.NewInt(0),
+		AuthList:  []types.SetCodeAuthorization{auth},
+	})
+	msg.From = suite.Address.Bytes()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.Signer))
+
+	res, err := suite.App.EvmKeeper.ApplyTransaction(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(msg.Hash().Hex(), res.Hash)
+	suite.Require().GreaterOrEqual(res.GasUsed, params.TxGas+types.TxAuthEmptyAccountGas)
+	// the delegated code runs in the context of the authority
+	suite.Require().Equal(common.LeftPadBytes(authority.Bytes(), 32), res.Ret)
+
+	// the delegation is committed and the nonce of the authority is bumped
+	vmdb = suite.StateDB()
+	delegation, ok := vmdb.GetDelegation(authority)
+	suite.Require().True(ok)
+	suite.Require().Equal(target, delegation)
+	suite.Require().Equal(uint64(1), vmdb.GetNonce(authority))
+}
+
 func (suite *StateTransitionTestSuite) TestApplyMessageWithConfigTracer() {
 	expectedGasUsed := params.TxGas
 	var msg *core.Message
@@ -113,6 +113,9 @@ func VerifyFee(
 			isContractCreation, homestead, istanbul, shanghai,
 		)
 	}
+	if auths := msg.SetCodeAuthorizations(); len(auths) > 0 {
+		intrinsicGas += uint64(len(auths)) * types.TxAuthEmptyAccountGas
+	}
 
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
@@ -105,6 +105,9 @@ type StateDB struct {
 	// EVM Tracer
 	evmTracer *cosmostracing.Hooks
 
+	// callTarget is the account called by the EVM, its code is the next one loaded
+	callTarget *common.Address
+
 	// handle balances natively
 	evmDenom string
 	err      error
@@ -230,7 +233,33 @@ func (s *StateDB) GetNonce(addr common.Address) uint64 {
 }
 
 // GetCode returns the code of account, nil if not exists.
+// The code of an EIP-7702 delegated account is the delegation designator, except when the
+// account is the call target, then the code of the delegation target is returned to be executed.
 func (s *StateDB) GetCode(addr common.Address) []byte {
+	code := s.getCode(addr)
+	if s.callTarget != nil && *s.callTarget == addr {
+		s.callTarget = nil
+		if target, ok := types.ParseDelegation(code); ok {
+			return s.getCode(target)
+		}
+	}
+	return code
+}
+
+// SetCallTarget sets the account called by the EVM, go-ethereum loads the code to execute with
+// GetCode, like the EXTCODECOPY opcode. So only the next GetCode of the account resolves its
+// delegation, the target is cleared by the call frame exit otherwise.
+func (s *StateDB) SetCallTarget(addr *common.Address) {
+	s.callTarget = addr
+}
+
+// GetDelegation returns the EIP-7702 delegation target of the account, if any.
+func (s *StateDB) GetDelegation(addr common.Address) (common.Address, bool) {
+	return types.ParseDelegation(s.getCode(addr))
+}
+
+// getCode returns the code stored in the account.
+func (s *StateDB) getCode(addr common.Address) []byte {
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
 		return stateObject.Code()
@@ -565,7 +594,7 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) {
 		// collect code changes only if tracer is active to reduce reads to
 		// StateDB
 		if s.evmTracer != nil && s.evmTracer.OnCodeChange != nil {
-			oldCode := s.GetCode(addr)
+			oldCode := s.getCode(addr)
 			var oldCodeHash common.Hash
 			if oldCode != nil {
 				oldCodeHash = crypto.Keccak256Hash(oldCode)
@@ -577,6 +606,47 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) {
 	}
 }
 
+// ApplyAuthorization applies an EIP-7702 authorization tuple, it bumps the nonce of the
+// authority and writes the delegation designator to its code. An invalid tuple is returned
+// as an error without modifying the authority, the caller is expected to skip it.
+func (s *StateDB) ApplyAuthorization(chainID *big.Int, auth types.SetCodeAuthorization) error {
+	if auth.ChainID.ToInt().Sign() != 0 && auth.ChainID.ToInt().Cmp(chainID) != 0 {
+		return types.ErrAuthorizationWrongChainID
+	}
+	if uint64(auth.Nonce)+1 < uint64(auth.Nonce) {
+		return types.ErrAuthorizationNonceOverflow
+	}
+	authority, err := auth.Authority()
+	if err != nil {
+		return err
+	}
+	// the authority is warm whether the tuple is valid or not
+	s.AddAddressToAccessList(authority)
+
+	if code := s.getCode(authority); len(code) != 0 {
+		if _, ok := types.ParseDelegation(code); !ok {
+			return types.ErrAuthorizationDestinationHasCode
+		}
+	}
+	if have := s.GetNonce(authority); have != uint64(auth.Nonce) {
+		return types.ErrAuthorizationNonceMismatch
+	}
+
+	// the intrinsic gas charged the tuple for an empty account
+	if s.Exist(authority) {
+		s.AddRefund(types.TxAuthEmptyAccountGas - types.TxAuthTupleGas)
+	}
+
+	s.SetNonce(authority, uint64(auth.Nonce)+1)
+	if auth.Address == (common.Address{}) {
+		// delegation to the zero address clears the code
+		s.SetCode(authority, nil)
+		return nil
+	}
+	s.SetCode(authority, types.AddressToDelegation(auth.Address))
+	return nil
+}
+
 // SetState sets the contract state.
 func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	if s.evmTracer != nil && s.evmTracer.OnStorageChange != nil {
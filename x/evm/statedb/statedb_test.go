@@ -24,6 +24,7 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtracing "github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -516,6 +517,79 @@ func (suite *StateDBTestSuite) TestCode() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestApplyAuthorization() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(key.PublicKey)
+	code := []byte("hello world")
+	chainID := big.NewInt(9000)
+
+	sign := func(chainID int64, target common.Address, nonce uint64) evmtypes.SetCodeAuthorization {
+		auth, err := evmtypes.SignSetCode(key, evmtypes.SetCodeAuthorization{
+			ChainID: hexutil.Big(*big.NewInt(chainID)),
+			Address: target,
+			Nonce:   hexutil.Uint64(nonce),
+		})
+		suite.Require().NoError(err)
+		return auth
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(*statedb.StateDB)
+		auth      evmtypes.SetCodeAuthorization
+		expErr    error
+		expNonce  uint64
+		expTarget common.Address
+	}{
+		{"delegate", func(*statedb.StateDB) {}, sign(9000, address2, 0), nil, 1, address2},
+		{"any chain id", func(*statedb.StateDB) {}, sign(0, address2, 0), nil, 1, address2},
+		{"wrong chain id", func(*statedb.StateDB) {}, sign(1, address2, 0), evmtypes.ErrAuthorizationWrongChainID, 0, common.Address{}},
+		{"nonce mismatch", func(*statedb.StateDB) {}, sign(9000, address2, 1), evmtypes.ErrAuthorizationNonceMismatch, 0, common.Address{}},
+		{"authority is a contract", func(db *statedb.StateDB) {
+			db.SetCode(authority, code)
+		}, sign(9000, address2, 0), evmtypes.ErrAuthorizationDestinationHasCode, 0, common.Address{}},
+		{"redelegate", func(db *statedb.StateDB) {
+			db.SetCode(authority, evmtypes.AddressToDelegation(address))
+			db.SetNonce(authority, 1)
+		}, sign(9000, address2, 1), nil, 2, address2},
+		{"clear delegation", func(db *statedb.StateDB) {
+			db.SetCode(authority, evmtypes.AddressToDelegation(address))
+		}, sign(9000, common.Address{}, 0), nil, 1, common.Address{}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, ctx, keeper := setupTestEnv(suite.T())
+			db := statedb.New(ctx, keeper, emptyTxConfig)
+			db.SetCode(address2, code)
+			tc.malleate(db)
+
+			err := db.ApplyAuthorization(chainID, tc.auth)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expNonce, db.GetNonce(authority))
+
+			target, ok := db.GetDelegation(authority)
+			suite.Require().Equal(tc.expTarget != common.Address{}, ok)
+			suite.Require().Equal(tc.expTarget, target)
+			if ok {
+				// the code reads see the designator, only the call loads the delegated code once
+				designator := evmtypes.AddressToDelegation(target)
+				suite.Require().Equal(designator, db.GetCode(authority))
+				suite.Require().Equal(len(designator), db.GetCodeSize(authority))
+				suite.Require().Equal(crypto.Keccak256Hash(designator), db.GetCodeHash(authority))
+				db.SetCallTarget(&authority)
+				suite.Require().Equal(code, db.GetCode(authority))
+				suite.Require().Equal(designator, db.GetCode(authority))
+			}
+		})
+	}
+}
+
 type codeChange struct {
 	addr    string
 	oldCode string
@@ -1178,7 +1252,7 @@ func newTestKeeper(t *testing.T, cms storetypes.MultiStore) (sdk.Context, *evmke
 	evmKeeper := evmkeeper.NewKeeper(
 		appCodec,
 		testStoreKeys[evmtypes.StoreKey], testObjKeys[evmtypes.ObjectStoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		accountKeeper, bankKeeper, nil, nil,
+		accountKeeper, authkeeper.NewQueryServer(accountKeeper), bankKeeper, nil, nil,
 		paramstypes.Subspace{},
 		nil,
 	)
@@ -0,0 +1,425 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/holiman/uint256"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/Helios-Chain-Labs/ethermint/types"
+)
+
+func newBlobTx(tx *ethtypes.Transaction) (*BlobTx, error) {
+	txData := &BlobTx{
+		Nonce:    tx.Nonce(),
+		Data:     tx.Data(),
+		GasLimit: tx.Gas(),
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	if to := tx.To(); to != nil {
+		txData.To = to.Hex()
+	}
+
+	if tx.Value() != nil {
+		amountInt, err := types.SafeNewIntFromBigInt(tx.Value())
+		if err != nil {
+			return nil, err
+		}
+		txData.Amount = &amountInt
+	}
+
+	if tx.GasFeeCap() != nil {
+		gasFeeCapInt, err := types.SafeNewIntFromBigInt(tx.GasFeeCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasFeeCap = &gasFeeCapInt
+	}
+
+	if tx.GasTipCap() != nil {
+		gasTipCapInt, err := types.SafeNewIntFromBigInt(tx.GasTipCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasTipCap = &gasTipCapInt
+	}
+
+	if tx.BlobGasFeeCap() != nil {
+		blobFeeCapInt, err := types.SafeNewIntFromBigInt(tx.BlobGasFeeCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.MaxFeePerBlobGas = &blobFeeCapInt
+	}
+
+	if tx.AccessList() != nil {
+		al := tx.AccessList()
+		txData.Accesses = NewAccessList(&al)
+	}
+
+	for _, hash := range tx.BlobHashes() {
+		txData.BlobVersionedHashes = append(txData.BlobVersionedHashes, hash.Hex())
+	}
+
+	txData.SetSignatureValues(tx.ChainId(), v, r, s)
+	return txData, nil
+}
+
+// TxType returns the tx type
+func (tx *BlobTx) TxType() uint8 {
+	return ethtypes.BlobTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *BlobTx) Copy() TxData {
+	return &BlobTx{
+		ChainID:             tx.ChainID,
+		Nonce:               tx.Nonce,
+		GasTipCap:           tx.GasTipCap,
+		GasFeeCap:           tx.GasFeeCap,
+		GasLimit:            tx.GasLimit,
+		To:                  tx.To,
+		Amount:              tx.Amount,
+		Data:                common.CopyBytes(tx.Data),
+		Accesses:            tx.Accesses,
+		MaxFeePerBlobGas:    tx.MaxFeePerBlobGas,
+		BlobVersionedHashes: append([]string(nil), tx.BlobVersionedHashes...),
+		V:                   common.CopyBytes(tx.V),
+		R:                   common.CopyBytes(tx.R),
+		S:                   common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the BlobTx
+func (tx *BlobTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *BlobTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *BlobTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *BlobTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *BlobTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *BlobTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *BlobTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetBlobGasFeeCap returns the max fee per blob gas field.
+func (tx *BlobTx) GetBlobGasFeeCap() *big.Int {
+	if tx.MaxFeePerBlobGas == nil {
+		return nil
+	}
+	return tx.MaxFeePerBlobGas.BigInt()
+}
+
+// GetBlobHashes returns the versioned hashes of the blobs.
+func (tx *BlobTx) GetBlobHashes() []common.Hash {
+	if len(tx.BlobVersionedHashes) == 0 {
+		return nil
+	}
+	hashes := make([]common.Hash, len(tx.BlobVersionedHashes))
+	for i, hash := range tx.BlobVersionedHashes {
+		hashes[i] = common.HexToHash(hash)
+	}
+	return hashes
+}
+
+// GetBlobGas returns the blob gas consumed by the blobs of the transaction.
+func (tx *BlobTx) GetBlobGas() uint64 {
+	return params.BlobTxBlobGasPerBlob * uint64(len(tx.BlobVersionedHashes))
+}
+
+// GetValue returns the tx amount.
+func (tx *BlobTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *BlobTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *BlobTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns an BlobTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM. The blob sidecar is never part of it.
+func (tx *BlobTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	var to common.Address
+	if addr := tx.GetTo(); addr != nil {
+		to = *addr
+	}
+	return &ethtypes.BlobTx{
+		ChainID:    toUint256(tx.GetChainID()),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  toUint256(tx.GetGasTipCap()),
+		GasFeeCap:  toUint256(tx.GetGasFeeCap()),
+		Gas:        tx.GetGas(),
+		To:         to,
+		Value:      toUint256(tx.GetValue()),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		BlobFeeCap: toUint256(tx.GetBlobGasFeeCap()),
+		BlobHashes: tx.GetBlobHashes(),
+		V:          toUint256(v),
+		R:          toUint256(r),
+		S:          toUint256(s),
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *BlobTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *BlobTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx BlobTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.MaxFeePerBlobGas == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "blob gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if tx.MaxFeePerBlobGas.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "blob gas fee cap cannot be negative %s", tx.MaxFeePerBlobGas)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetBlobGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// blob transactions can't be contract creations
+	if tx.To == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "blob transaction must have a recipient")
+	}
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	for i, hash := range tx.BlobVersionedHashes {
+		bz, err := hexutil.Decode(hash)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidBlobTx, "invalid blob hash #%d: %s", i, err)
+		}
+		if len(bz) != common.HashLength {
+			return errorsmod.Wrapf(ErrInvalidBlobTx, "invalid blob hash #%d length: %d", i, len(bz))
+		}
+	}
+	if err := validateBlobHashes(tx.GetBlobHashes()); err != nil {
+		return err
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on Blob txs",
+		)
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+// The blob gas is not charged as the chain doesn't keep the blobs.
+func (tx BlobTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GasLimit)
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx BlobTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *BlobTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx BlobTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx BlobTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// validateBlobHashes checks the number of blobs and the version of each hash.
+func validateBlobHashes(hashes []common.Hash) error {
+	if len(hashes) == 0 {
+		return errorsmod.Wrap(ErrInvalidBlobTx, "blob transaction must have at least one blob")
+	}
+	if len(hashes) > params.MaxBlobGasPerBlock/params.BlobTxBlobGasPerBlob {
+		return errorsmod.Wrapf(ErrInvalidBlobTx, "too many blobs in transaction: have %d", len(hashes))
+	}
+	for i, hash := range hashes {
+		if !kzg4844.IsValidVersionedHash(hash[:]) {
+			return errorsmod.Wrapf(ErrInvalidBlobTx, "blob hash #%d has invalid version: %s", i, hash)
+		}
+	}
+	return nil
+}
+
+// VerifyBlobSidecar checks that the sidecar blobs match the versioned hashes of the transaction.
+// Adapted from the go-ethereum txpool validation.
+func VerifyBlobSidecar(hashes []common.Hash, sidecar *ethtypes.BlobTxSidecar) error {
+	if len(sidecar.Blobs) != len(hashes) {
+		return errorsmod.Wrapf(ErrInvalidBlobTx, "invalid number of %d blobs compared to %d blob hashes", len(sidecar.Blobs), len(hashes))
+	}
+	if len(sidecar.Commitments) != len(hashes) {
+		return errorsmod.Wrapf(ErrInvalidBlobTx, "invalid number of %d blob commitments compared to %d blob hashes", len(sidecar.Commitments), len(hashes))
+	}
+	if len(sidecar.Proofs) != len(hashes) {
+		return errorsmod.Wrapf(ErrInvalidBlobTx, "invalid number of %d blob proofs compared to %d blob hashes", len(sidecar.Proofs), len(hashes))
+	}
+	hasher := sha256.New()
+	for i, vhash := range hashes {
+		computed := kzg4844.CalcBlobHashV1(hasher, &sidecar.Commitments[i])
+		if vhash != computed {
+			return errorsmod.Wrapf(ErrInvalidBlobTx, "blob %d: computed hash %#x mismatches transaction one %#x", i, computed, vhash)
+		}
+	}
+	for i := range sidecar.Blobs {
+		if err := kzg4844.VerifyBlobProof(&sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidBlobTx, "invalid blob %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+func toUint256(i *big.Int) *uint256.Int {
+	if i == nil {
+		return nil
+	}
+	v, _ := uint256.FromBig(i)
+	return v
+}
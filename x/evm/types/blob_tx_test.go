@@ -0,0 +1,217 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// versionedHash returns a blob versioned hash with the KZG version byte.
+func versionedHash(b byte) common.Hash {
+	hash := common.Hash{0x01}
+	hash[31] = b
+	return hash
+}
+
+func (suite *TxDataTestSuite) TestNewBlobTx() {
+	testCases := []struct {
+		name     string
+		expError bool
+		tx       *ethtypes.Transaction
+	}{
+		{
+			"non-empty tx",
+			false,
+			ethtypes.NewTx(&ethtypes.BlobTx{
+				ChainID:    uint256.NewInt(1),
+				Nonce:      1,
+				Data:       []byte("data"),
+				Gas:        100,
+				Value:      uint256.NewInt(1),
+				AccessList: ethtypes.AccessList{},
+				To:         suite.addr,
+				BlobFeeCap: uint256.NewInt(1),
+				BlobHashes: []common.Hash{versionedHash(1)},
+				V:          uint256.NewInt(1),
+				R:          uint256.NewInt(1),
+				S:          uint256.NewInt(1),
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tx, err := newBlobTx(tc.tx)
+
+		if tc.expError {
+			suite.Require().Error(err)
+		} else {
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(tx)
+			suite.Require().Equal(uint8(3), tx.TxType())
+			suite.Require().Equal([]string{versionedHash(1).Hex()}, tx.BlobVersionedHashes)
+			suite.Require().Equal(big.NewInt(1), tx.GetBlobGasFeeCap())
+		}
+	}
+}
+
+func (suite *TxDataTestSuite) TestBlobTxAsEthereumData() {
+	blobConfig := &ethtypes.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		Nonce:      1,
+		Data:       []byte("data"),
+		Gas:        100,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(2),
+		Value:      uint256.NewInt(1),
+		AccessList: ethtypes.AccessList{},
+		To:         suite.addr,
+		BlobFeeCap: uint256.NewInt(3),
+		BlobHashes: []common.Hash{versionedHash(1), versionedHash(2)},
+		V:          uint256.NewInt(1),
+		R:          uint256.NewInt(1),
+		S:          uint256.NewInt(1),
+	}
+
+	tx := ethtypes.NewTx(blobConfig)
+
+	blobTx, err := newBlobTx(tx)
+	suite.Require().NoError(err)
+
+	res := blobTx.AsEthereumData()
+	resTx := ethtypes.NewTx(res)
+
+	suite.Require().Equal(tx.Hash(), resTx.Hash())
+	suite.Require().Equal(blobConfig.BlobHashes, resTx.BlobHashes())
+	suite.Require().Equal(blobConfig.BlobFeeCap.ToBig(), resTx.BlobGasFeeCap())
+	suite.Require().Equal(tx.BlobGas(), blobTx.GetBlobGas())
+	suite.Require().Equal(&suite.addr, resTx.To())
+}
+
+func (suite *TxDataTestSuite) TestBlobTxCopy() {
+	tx := &BlobTx{}
+	txCopy := tx.Copy()
+
+	suite.Require().Equal(&BlobTx{}, txCopy)
+}
+
+func (suite *TxDataTestSuite) TestBlobTxValidate() {
+	hashes := []string{versionedHash(1).Hex()}
+
+	testCases := []struct {
+		name     string
+		tx       BlobTx
+		expError bool
+	}{
+		{
+			"empty",
+			BlobTx{},
+			true,
+		},
+		{
+			"blob gas fee cap is nil",
+			BlobTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"blob gas fee cap is negative",
+			BlobTx{
+				GasTipCap:        &suite.sdkInt,
+				GasFeeCap:        &suite.sdkInt,
+				MaxFeePerBlobGas: &suite.sdkMinusOneInt,
+			},
+			true,
+		},
+		{
+			"contract creation",
+			BlobTx{
+				GasTipCap:           &suite.sdkInt,
+				GasFeeCap:           &suite.sdkInt,
+				MaxFeePerBlobGas:    &suite.sdkInt,
+				Amount:              &suite.sdkInt,
+				BlobVersionedHashes: hashes,
+				ChainID:             &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"no blob hashes",
+			BlobTx{
+				GasTipCap:        &suite.sdkInt,
+				GasFeeCap:        &suite.sdkInt,
+				MaxFeePerBlobGas: &suite.sdkInt,
+				Amount:           &suite.sdkInt,
+				To:               suite.hexAddr,
+				ChainID:          &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"invalid blob hash version",
+			BlobTx{
+				GasTipCap:           &suite.sdkInt,
+				GasFeeCap:           &suite.sdkInt,
+				MaxFeePerBlobGas:    &suite.sdkInt,
+				Amount:              &suite.sdkInt,
+				To:                  suite.hexAddr,
+				BlobVersionedHashes: []string{common.Hash{0x02}.Hex()},
+				ChainID:             &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"invalid blob hash length",
+			BlobTx{
+				GasTipCap:           &suite.sdkInt,
+				GasFeeCap:           &suite.sdkInt,
+				MaxFeePerBlobGas:    &suite.sdkInt,
+				Amount:              &suite.sdkInt,
+				To:                  suite.hexAddr,
+				BlobVersionedHashes: []string{"0x01"},
+				ChainID:             &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"chain ID not present on Blob txs",
+			BlobTx{
+				GasTipCap:           &suite.sdkInt,
+				GasFeeCap:           &suite.sdkInt,
+				MaxFeePerBlobGas:    &suite.sdkInt,
+				Amount:              &suite.sdkInt,
+				To:                  suite.hexAddr,
+				BlobVersionedHashes: hashes,
+				ChainID:             nil,
+			},
+			true,
+		},
+		{
+			"no errors",
+			BlobTx{
+				GasTipCap:           &suite.sdkInt,
+				GasFeeCap:           &suite.sdkInt,
+				MaxFeePerBlobGas:    &suite.sdkInt,
+				Amount:              &suite.sdkInt,
+				To:                  suite.hexAddr,
+				BlobVersionedHashes: hashes,
+				ChainID:             &suite.sdkInt,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.tx.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+	}
+}
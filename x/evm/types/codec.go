@@ -66,6 +66,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		"ethermint.evm.v1.TxData",
 		(*TxData)(nil),
 		&DynamicFeeTx{},
+		&BlobTx{},
 		&AccessListTx{},
 		&LegacyTx{},
 	)
@@ -51,6 +51,9 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrConfigOverrides
+	codeErrInvalidBlobTx
+	codeErrInvalidPrecompile
+	codeErrInvalidSetCodeTx
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -120,6 +123,15 @@ var (
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
 
 	ErrConfigOverrides = errorsmod.Register(ModuleName, codeErrConfigOverrides, "failed to apply state override")
+
+	// ErrInvalidBlobTx returns an error if the blob fields of a blob transaction are invalid
+	ErrInvalidBlobTx = errorsmod.Register(ModuleName, codeErrInvalidBlobTx, "invalid blob transaction")
+
+	// ErrInvalidPrecompile returns an error if an active precompiled contract is not registered
+	ErrInvalidPrecompile = errorsmod.Register(ModuleName, codeErrInvalidPrecompile, "invalid precompiled contract")
+
+	// ErrInvalidSetCodeTx returns an error if the fields of an EIP-7702 set code transaction are invalid
+	ErrInvalidSetCodeTx = errorsmod.Register(ModuleName, codeErrInvalidSetCodeTx, "invalid set code transaction")
 )
 
 // VmError is an interface that represents a reverted or failed EVM execution.
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/Helios-Chain-Labs/ethermint/types"
@@ -11,16 +12,78 @@ import (
 
 type EthereumTx struct {
 	*ethtypes.Transaction
+	// setCode is the EIP-7702 transaction, which go-ethereum can't represent. Transaction is then its
+	// dynamic fee view, while the hash, type and encoding are the ones of setCode.
+	setCode *SetCodeTx
 }
 
 func NewEthereumTx(txData ethtypes.TxData) EthereumTx {
-	return EthereumTx{ethtypes.NewTx(txData)}
+	return EthereumTx{Transaction: ethtypes.NewTx(txData)}
+}
+
+// NewSetCodeEthereumTx returns the EthereumTx of an EIP-7702 set code transaction.
+func NewSetCodeEthereumTx(tx *SetCodeTx) EthereumTx {
+	return EthereumTx{Transaction: tx.AsDynamicFeeTx(), setCode: tx}
+}
+
+// SetCode returns the EIP-7702 set code transaction, nil for the other types.
+func (tx EthereumTx) SetCode() *SetCodeTx {
+	return tx.setCode
+}
+
+// Type returns the transaction type.
+func (tx EthereumTx) Type() uint8 {
+	if tx.setCode != nil {
+		return SetCodeTxType
+	}
+	return tx.Transaction.Type()
+}
+
+// Hash returns the transaction hash.
+func (tx EthereumTx) Hash() common.Hash {
+	if tx.setCode != nil {
+		return tx.setCode.Hash()
+	}
+	return tx.Transaction.Hash()
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+func (tx EthereumTx) MarshalBinary() ([]byte, error) {
+	if tx.setCode != nil {
+		return tx.setCode.MarshalBinary()
+	}
+	return tx.Transaction.MarshalBinary()
+}
+
+// UnmarshalBinary decodes the canonical encoding of transactions, including the set code ones.
+func (tx *EthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		setCode, err := DecodeSetCodeTx(b)
+		if err != nil {
+			return err
+		}
+		*tx = NewSetCodeEthereumTx(setCode)
+		return nil
+	}
+	inner := new(ethtypes.Transaction)
+	if err := inner.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	*tx = EthereumTx{Transaction: inner}
+	return nil
 }
 
 func (tx EthereumTx) Size() int {
 	if tx.Transaction == nil {
 		return 0
 	}
+	if tx.setCode != nil {
+		bz, err := tx.setCode.MarshalBinary()
+		if err != nil {
+			return 0
+		}
+		return len(bz)
+	}
 	return int(tx.Transaction.Size())
 }
 
@@ -38,12 +101,9 @@ func (tx EthereumTx) MarshalTo(dst []byte) (int, error) {
 
 func (tx *EthereumTx) Unmarshal(dst []byte) error {
 	if len(dst) == 0 {
-		tx.Transaction = nil
+		*tx = EthereumTx{}
 		return nil
 	}
-	if tx.Transaction == nil {
-		tx.Transaction = new(ethtypes.Transaction)
-	}
 	return tx.UnmarshalBinary(dst)
 }
 
@@ -80,5 +140,30 @@ func (tx EthereumTx) Validate() error {
 	if !types.IsValidInt256(tx.Cost()) {
 		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
 	}
+	if tx.setCode != nil {
+		if len(tx.setCode.AuthList) == 0 {
+			return errorsmod.Wrap(ErrInvalidSetCodeTx, "authorization list must not be empty")
+		}
+		for _, auth := range tx.setCode.AuthList {
+			if !types.IsValidInt256(auth.ChainID.ToInt()) {
+				return errorsmod.Wrap(ErrInvalidSetCodeTx, "authorization chain id out of bound")
+			}
+		}
+	}
+	if tx.Type() == ethtypes.BlobTxType {
+		// blobs are not kept on chain, the sidecar must be dropped before broadcasting
+		if tx.BlobTxSidecar() != nil {
+			return errorsmod.Wrap(ErrInvalidBlobTx, "blob sidecar must not be included")
+		}
+		if !types.IsValidInt256(tx.BlobGasFeeCap()) {
+			return errorsmod.Wrap(ErrInvalidGasPrice, "out of bound")
+		}
+		if tx.To() == nil {
+			return errorsmod.Wrap(ErrInvalidBlobTx, "blob transaction must have a recipient")
+		}
+		if err := validateBlobHashes(tx.BlobHashes()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
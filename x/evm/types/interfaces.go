@@ -27,8 +27,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	feemarkettypes "github.com/Helios-Chain-Labs/ethermint/x/feemarket/types"
 )
 
@@ -43,6 +45,11 @@ type AccountKeeper interface {
 	RemoveAccount(ctx context.Context, account sdk.AccountI)
 }
 
+// AccountQuerier defines the expected auth query server to page the accounts by address.
+type AccountQuerier interface {
+	Accounts(ctx context.Context, req *authtypes.QueryAccountsRequest) (*authtypes.QueryAccountsResponse, error)
+}
+
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	authtypes.BankKeeper
@@ -70,6 +77,15 @@ type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 }
 
+// StatefulPrecompiledContract is a precompiled contract which has access to the EVM and the calling
+// contract, unlike the go-ethereum PrecompiledContract which only receives the input. The gas consumed by Run
+// with contract.UseGas is charged on top of RequiredGas.
+type StatefulPrecompiledContract interface {
+	Address() common.Address
+	RequiredGas(input []byte) uint64
+	Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error)
+}
+
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
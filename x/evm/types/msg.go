@@ -130,13 +130,18 @@ func newMsgEthereumTx(
 	return NewTxWithData(txData)
 }
 
+// NewSetCodeTx returns a reference to a new EIP-7702 set code transaction message.
+func NewSetCodeTx(tx *SetCodeTx) *MsgEthereumTx {
+	return &MsgEthereumTx{Raw: NewSetCodeEthereumTx(tx)}
+}
+
 func (msg *MsgEthereumTx) FromEthereumTx(tx *ethtypes.Transaction) {
-	msg.Raw.Transaction = tx
+	msg.Raw = EthereumTx{Transaction: tx}
 }
 
 // FromSignedEthereumTx populates the message fields from the given signed ethereum transaction, and set From field.
 func (msg *MsgEthereumTx) FromSignedEthereumTx(tx *ethtypes.Transaction, signer ethtypes.Signer) error {
-	msg.Raw.Transaction = tx
+	msg.Raw = EthereumTx{Transaction: tx}
 
 	from, err := ethtypes.Sender(signer, tx)
 	if err != nil {
@@ -216,7 +221,11 @@ func (msg *MsgEthereumTx) GetSenderLegacy(signer ethtypes.Signer) (common.Addres
 
 // recoverSender recovers the sender address from the transaction signature.
 func (msg *MsgEthereumTx) recoverSender(signer ethtypes.Signer) (common.Address, error) {
-	return ethtypes.Sender(signer, msg.AsTransaction())
+	tx := msg.AsTransaction()
+	if setCode := msg.Raw.SetCode(); setCode != nil {
+		return setCode.Sender(signer.ChainID())
+	}
+	return ethtypes.Sender(signer, tx)
 }
 
 // GetSignBytes returns the Amino bytes of an Ethereum transaction message used
@@ -242,19 +251,35 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 	}
 
 	tx := msg.AsTransaction()
+	setCode := msg.Raw.SetCode()
 	txHash := ethSigner.Hash(tx)
+	if setCode != nil {
+		if setCode.ChainID == nil || setCode.ChainID.Cmp(ethSigner.ChainID()) != 0 {
+			return fmt.Errorf("%w: have %d want %d", ethtypes.ErrInvalidChainId, setCode.ChainID, ethSigner.ChainID())
+		}
+		txHash = setCode.SigHash()
+	}
 
 	sig, _, err := keyringSigner.SignByAddress(from, txHash.Bytes(), signing.SignMode_SIGN_MODE_TEXTUAL)
 	if err != nil {
 		return err
 	}
 
+	if setCode != nil {
+		setCode, err = setCode.WithSignature(sig)
+		if err != nil {
+			return err
+		}
+		msg.Raw = NewSetCodeEthereumTx(setCode)
+		return nil
+	}
+
 	tx, err = tx.WithSignature(ethSigner, sig)
 	if err != nil {
 		return err
 	}
 
-	msg.Raw.Transaction = tx
+	msg.Raw = EthereumTx{Transaction: tx}
 	return nil
 }
 
@@ -308,6 +333,42 @@ func (msg *MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return msg.Raw.Transaction
 }
 
+// TxType returns the type of the Ethereum transaction, AsTransaction reports the EIP-7702 set code
+// transactions as dynamic fee ones.
+func (msg *MsgEthereumTx) TxType() uint8 {
+	msg.AsTransaction()
+	return msg.Raw.Type()
+}
+
+// SetCodeAuthorizations returns the EIP-7702 authorization list of the transaction, nil for the other types.
+func (msg *MsgEthereumTx) SetCodeAuthorizations() []SetCodeAuthorization {
+	if setCode := msg.Raw.SetCode(); setCode != nil {
+		return setCode.AuthList
+	}
+	return nil
+}
+
+// MarshalBinary returns the canonical encoding of the Ethereum transaction.
+func (msg *MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	msg.AsTransaction()
+	return msg.Raw.MarshalBinary()
+}
+
+// ToMessage recovers the sender from the transaction signature and returns the Ethereum core.Message,
+// like core.TransactionToMessage.
+func (msg *MsgEthereumTx) ToMessage(signer ethtypes.Signer, baseFee *big.Int) (*core.Message, error) {
+	if msg.Raw.SetCode() == nil {
+		return core.TransactionToMessage(msg.AsTransaction(), signer, baseFee)
+	}
+	from, err := msg.recoverSender(signer)
+	if err != nil {
+		return nil, err
+	}
+	ethMsg := msg.AsMessage(baseFee)
+	ethMsg.From = from
+	return ethMsg, nil
+}
+
 // AsMessage creates an Ethereum core.Message from the msg fields
 func (msg *MsgEthereumTx) AsMessage(baseFee *big.Int) *core.Message {
 	tx := msg.AsTransaction()
@@ -321,6 +382,8 @@ func (msg *MsgEthereumTx) AsMessage(baseFee *big.Int) *core.Message {
 		Value:             tx.Value(),
 		Data:              tx.Data(),
 		AccessList:        tx.AccessList(),
+		BlobHashes:        tx.BlobHashes(),
+		BlobGasFeeCap:     tx.BlobGasFeeCap(),
 		// SkipAccountChecks: false,
 
 		From: common.BytesToAddress(msg.From),
@@ -352,15 +415,23 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte, signer ethtypes.Signer) error {
-	tx := &ethtypes.Transaction{}
+	var tx EthereumTx
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
 	}
-	return msg.FromSignedEthereumTx(tx, signer)
+	msg.Raw = tx
+
+	from, err := msg.recoverSender(signer)
+	if err != nil {
+		return err
+	}
+	msg.From = from.Bytes()
+	return nil
 }
 
 func (msg *MsgEthereumTx) Hash() common.Hash {
-	return msg.AsTransaction().Hash()
+	msg.AsTransaction()
+	return msg.Raw.Hash()
 }
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
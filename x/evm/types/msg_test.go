@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/Helios-Chain-Labs/ethermint/crypto/ethsecp256k1"
@@ -518,6 +519,76 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Sign() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_SetCode() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	auth, err := types.SignSetCode(key, types.SetCodeAuthorization{
+		ChainID: hexutil.Big(*suite.chainID),
+		Address: tests.GenerateAddress(),
+		Nonce:   1,
+	})
+	suite.Require().NoError(err)
+
+	msg := types.NewSetCodeTx(&types.SetCodeTx{
+		ChainID:   suite.chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       100000,
+		To:        suite.to,
+		Value:     big.NewInt(0),
+		Data:      []byte("test"),
+		AuthList:  []types.SetCodeAuthorization{auth},
+	})
+	msg.From = suite.from.Bytes()
+	signer := ethtypes.LatestSignerForChainID(suite.chainID)
+	suite.Require().NoError(msg.Sign(signer, suite.signer))
+	suite.Require().NoError(msg.VerifySender(signer))
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal(uint8(types.SetCodeTxType), msg.TxType())
+	suite.Require().Equal([]types.SetCodeAuthorization{auth}, msg.SetCodeAuthorizations())
+
+	// the hash is the one of the type-4 envelope, not the one of the dynamic fee form
+	bz, err := msg.MarshalBinary()
+	suite.Require().NoError(err)
+	suite.Require().Equal(byte(types.SetCodeTxType), bz[0])
+	suite.Require().Equal(crypto.Keccak256Hash(bz), msg.Hash())
+	suite.Require().NotEqual(msg.AsTransaction().Hash(), msg.Hash())
+
+	// the sender is recovered from the envelope, and it's only valid for its chain id
+	var decoded types.MsgEthereumTx
+	suite.Require().NoError(decoded.UnmarshalBinary(bz, signer))
+	suite.Require().Equal(msg.Hash(), decoded.Hash())
+	suite.Require().Equal(suite.from.Bytes(), decoded.From)
+	suite.Require().Equal(msg.SetCodeAuthorizations(), decoded.SetCodeAuthorizations())
+	suite.Require().Error(decoded.UnmarshalBinary(bz, ethtypes.LatestSignerForChainID(big.NewInt(2))))
+
+	ethMsg, err := msg.ToMessage(signer, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, ethMsg.From)
+	suite.Require().Equal(suite.to, *ethMsg.To)
+
+	// the envelope survives the proto encoding of the msg
+	protoBz, err := msg.Marshal()
+	suite.Require().NoError(err)
+	var protoMsg types.MsgEthereumTx
+	suite.Require().NoError(protoMsg.Unmarshal(protoBz))
+	suite.Require().Equal(msg.Hash(), protoMsg.Hash())
+	suite.Require().NoError(protoMsg.VerifySender(signer))
+
+	// an empty authorization list is rejected
+	msg = types.NewSetCodeTx(&types.SetCodeTx{
+		ChainID:   suite.chainID,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       100000,
+		Value:     big.NewInt(0),
+	})
+	msg.From = suite.from.Bytes()
+	suite.Require().NoError(msg.Sign(signer, suite.signer))
+	suite.Require().ErrorIs(msg.ValidateBasic(), types.ErrInvalidSetCodeTx)
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_Getters() {
 	testCases := []struct {
 		name      string
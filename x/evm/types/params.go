@@ -20,6 +20,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -36,7 +37,13 @@ var (
 )
 
 // NewParams creates a new Params instance
-func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
+func NewParams(
+	evmDenom string,
+	allowUnprotectedTxs, enableCreate, enableCall bool,
+	config ChainConfig,
+	extraEIPs []int64,
+	activePrecompiles []string,
+) Params {
 	return Params{
 		EvmDenom:            evmDenom,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
@@ -44,6 +51,7 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		ActivePrecompiles:   activePrecompiles,
 	}
 }
 
@@ -82,6 +90,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
 	return ValidateChainConfig(p.ChainConfig)
 }
 
@@ -94,6 +106,15 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// ActivePrecompileAddresses returns the ActivePrecompiles as addresses
+func (p Params) ActivePrecompileAddresses() []common.Address {
+	addrs := make([]common.Address, len(p.ActivePrecompiles))
+	for i, precompile := range p.ActivePrecompiles {
+		addrs[i] = common.HexToAddress(precompile)
+	}
+	return addrs
+}
+
 func ValidateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -125,6 +146,27 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// ValidatePrecompiles checks the precompiled contracts are unique hex addresses
+func ValidatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid precompile slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]struct{}, len(precompiles))
+	for _, precompile := range precompiles {
+		if !common.IsHexAddress(precompile) {
+			return fmt.Errorf("invalid precompile address %s", precompile)
+		}
+		addr := common.HexToAddress(precompile)
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate precompile address %s", precompile)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
 func ValidateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
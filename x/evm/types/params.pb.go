@@ -39,6 +39,9 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// active_precompiles defines the hex addresses of the custom precompiled contracts enabled on the chain,
+	// they must be registered in the evm keeper.
+	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty" yaml:"active_precompiles"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -116,6 +119,13 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetActivePrecompiles() []string {
+	if m != nil {
+		return m.ActivePrecompiles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
 }
@@ -123,32 +133,35 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/params.proto", fileDescriptor_e7d3c06c1322f20f) }
 
 var fileDescriptor_e7d3c06c1322f20f = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0x86, 0x93, 0xed, 0x5a, 0x36, 0xd3, 0x15, 0xd6, 0xb1, 0x4a, 0x58, 0xd8, 0x24, 0x44, 0x90,
-	0x9c, 0x12, 0xba, 0x1e, 0x04, 0x41, 0xd0, 0xd4, 0x0a, 0xde, 0x96, 0xa0, 0x17, 0x2f, 0x61, 0x9a,
-	0x7e, 0xa6, 0x81, 0x99, 0x4c, 0xc8, 0x4c, 0x63, 0xfa, 0x2f, 0xfc, 0x59, 0x3d, 0xf6, 0xe8, 0x29,
-	0x48, 0xfa, 0x0f, 0xf2, 0x0b, 0x24, 0xd3, 0xda, 0x56, 0xbd, 0xcd, 0xf7, 0x3d, 0xef, 0xfb, 0xc1,
-	0xbc, 0x2f, 0xba, 0x03, 0xb9, 0x84, 0x92, 0x65, 0xb9, 0x0c, 0xa0, 0x62, 0x41, 0x35, 0x09, 0x0a,
-	0x52, 0x12, 0x26, 0xfc, 0xa2, 0xe4, 0x92, 0xe3, 0x9b, 0x23, 0xf6, 0xa1, 0x62, 0x7e, 0x35, 0xb9,
-	0x1d, 0xa7, 0x3c, 0xe5, 0x0a, 0x06, 0xfd, 0x6b, 0xaf, 0xbb, 0x7d, 0xf1, 0xdf, 0x99, 0x64, 0x49,
-	0xb2, 0x3c, 0x4e, 0x78, 0xfe, 0x2d, 0x4b, 0xf7, 0x22, 0xb7, 0xbb, 0x40, 0xc3, 0x07, 0x75, 0x1d,
-	0x4f, 0x90, 0x01, 0x15, 0x8b, 0x17, 0x90, 0x73, 0x66, 0xea, 0x8e, 0xee, 0x19, 0xe1, 0xb8, 0x6b,
-	0xec, 0x9b, 0x35, 0x61, 0xf4, 0x8d, 0x7b, 0x44, 0x6e, 0x74, 0x05, 0x15, 0xfb, 0xd0, 0x3f, 0xf1,
-	0x5b, 0xf4, 0x18, 0x72, 0x32, 0xa7, 0x10, 0x27, 0x25, 0x10, 0x09, 0xe6, 0x85, 0xa3, 0x7b, 0x57,
-	0xa1, 0xd9, 0x35, 0xf6, 0xf8, 0x60, 0x3b, 0xc7, 0x6e, 0x74, 0xbd, 0x9f, 0xa7, 0x6a, 0xc4, 0xaf,
-	0xd1, 0xe8, 0x0f, 0x27, 0x94, 0x9a, 0x03, 0x65, 0x7e, 0xde, 0x35, 0x36, 0xfe, 0xdb, 0x4c, 0x28,
-	0x75, 0x23, 0x74, 0xb0, 0x12, 0x4a, 0xf1, 0x7b, 0x84, 0xa0, 0x96, 0x25, 0x89, 0x21, 0x2b, 0x84,
-	0x79, 0xe9, 0x0c, 0xbc, 0x41, 0xe8, 0xb6, 0x8d, 0x6d, 0xcc, 0xfa, 0xed, 0xec, 0xd3, 0x83, 0xe8,
-	0x1a, 0xfb, 0xc9, 0xe1, 0xc8, 0x51, 0xe8, 0x46, 0x86, 0x1a, 0x66, 0x59, 0x21, 0xf0, 0x47, 0x74,
-	0x7d, 0x1e, 0x87, 0xf9, 0xc8, 0xd1, 0xbd, 0xd1, 0xfd, 0x9d, 0xff, 0x6f, 0xb8, 0xfe, 0xb4, 0x57,
-	0x4d, 0x95, 0x28, 0xbc, 0xdc, 0x34, 0xb6, 0x16, 0x8d, 0x92, 0xd3, 0x0a, 0xdf, 0xa3, 0x67, 0x84,
-	0x52, 0xfe, 0x3d, 0x5e, 0xe5, 0x7d, 0xa2, 0x90, 0x48, 0x58, 0xc4, 0xb2, 0x16, 0xe6, 0xb0, 0xff,
-	0x4d, 0xf4, 0x54, 0xc1, 0x2f, 0x27, 0xf6, 0xb9, 0x16, 0xe1, 0xbb, 0x4d, 0x6b, 0xe9, 0xdb, 0xd6,
-	0xd2, 0x7f, 0xb5, 0x96, 0xfe, 0x63, 0x67, 0x69, 0xdb, 0x9d, 0xa5, 0xfd, 0xdc, 0x59, 0xda, 0xd7,
-	0x97, 0x69, 0x26, 0x97, 0xab, 0xb9, 0x9f, 0x70, 0xd6, 0x97, 0xc6, 0x45, 0x70, 0x2a, 0xb1, 0x56,
-	0x35, 0xca, 0x75, 0x01, 0x62, 0x3e, 0x54, 0xed, 0xbd, 0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x51,
-	0x16, 0x95, 0xc2, 0x2b, 0x02, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x52, 0x42, 0xbd, 0x29, 0x52, 0xbb, 0x04, 0x64, 0x2a, 0xc5, 0xb6, 0xcc, 0xc5,
+	0x97, 0xda, 0x4a, 0x38, 0x20, 0x21, 0x71, 0xc0, 0x21, 0x08, 0xa4, 0x1c, 0x22, 0x0b, 0x2e, 0x5c,
+	0xac, 0x8d, 0x3b, 0x24, 0x2b, 0xed, 0x7a, 0x2d, 0xef, 0xd6, 0xa4, 0x6f, 0xc1, 0x23, 0x71, 0xec,
+	0xb1, 0x47, 0x4e, 0x16, 0x4a, 0xde, 0xc0, 0x4f, 0x80, 0xbc, 0x0e, 0x49, 0x69, 0x6f, 0x3b, 0xff,
+	0xf7, 0xff, 0xa3, 0x9d, 0xd1, 0xa0, 0x21, 0xa8, 0x15, 0x14, 0x9c, 0x66, 0x2a, 0x84, 0x92, 0x87,
+	0xe5, 0x28, 0xcc, 0x49, 0x41, 0xb8, 0x0c, 0xf2, 0x42, 0x28, 0x81, 0x4f, 0xf7, 0x38, 0x80, 0x92,
+	0x07, 0xe5, 0xe8, 0x7c, 0xb0, 0x14, 0x4b, 0xa1, 0x61, 0xd8, 0xbc, 0x5a, 0xdf, 0xf9, 0xab, 0x07,
+	0x6d, 0xd2, 0x15, 0xa1, 0x59, 0x92, 0x8a, 0xec, 0x3b, 0x5d, 0xb6, 0x26, 0xef, 0x57, 0x17, 0xf5,
+	0xe6, 0xba, 0x3b, 0x1e, 0x21, 0x13, 0x4a, 0x9e, 0x5c, 0x42, 0x26, 0xb8, 0x65, 0xb8, 0x86, 0x6f,
+	0x46, 0x83, 0xba, 0x72, 0x4e, 0xaf, 0x09, 0x67, 0x6f, 0xbd, 0x3d, 0xf2, 0xe2, 0x63, 0x28, 0xf9,
+	0x87, 0xe6, 0x89, 0xdf, 0xa1, 0xa7, 0x90, 0x91, 0x05, 0x83, 0x24, 0x2d, 0x80, 0x28, 0xb0, 0x1e,
+	0xb9, 0x86, 0x7f, 0x1c, 0x59, 0x75, 0xe5, 0x0c, 0x76, 0xb1, 0xbb, 0xd8, 0x8b, 0x4f, 0xda, 0x7a,
+	0xa2, 0x4b, 0xfc, 0x06, 0xf5, 0xff, 0x71, 0xc2, 0x98, 0xd5, 0xd5, 0xe1, 0x17, 0x75, 0xe5, 0xe0,
+	0xff, 0xc3, 0x84, 0x31, 0x2f, 0x46, 0xbb, 0x28, 0x61, 0x0c, 0xbf, 0x47, 0x08, 0xd6, 0xaa, 0x20,
+	0x09, 0xd0, 0x5c, 0x5a, 0x47, 0x6e, 0xd7, 0xef, 0x46, 0xde, 0xa6, 0x72, 0xcc, 0x69, 0xa3, 0x4e,
+	0x3f, 0xcf, 0x65, 0x5d, 0x39, 0x67, 0xbb, 0x26, 0x7b, 0xa3, 0x17, 0x9b, 0xba, 0x98, 0xd2, 0x5c,
+	0xe2, 0x8f, 0xe8, 0xe4, 0xee, 0x3a, 0xac, 0xc7, 0xae, 0xe1, 0xf7, 0xc7, 0xc3, 0xe0, 0xfe, 0x72,
+	0x83, 0x49, 0xe3, 0x9a, 0x68, 0x53, 0x74, 0x74, 0x53, 0x39, 0x9d, 0xb8, 0x9f, 0x1e, 0x24, 0x3c,
+	0x46, 0xcf, 0x09, 0x63, 0xe2, 0x47, 0x72, 0x95, 0x35, 0x1b, 0x85, 0x54, 0xc1, 0x65, 0xa2, 0xd6,
+	0xd2, 0xea, 0x35, 0xd3, 0xc4, 0xcf, 0x34, 0xfc, 0x7a, 0x60, 0x5f, 0xd6, 0x12, 0xcf, 0x10, 0x26,
+	0xa9, 0xa2, 0x25, 0x24, 0x79, 0x01, 0xa9, 0xe0, 0x39, 0x65, 0x20, 0xad, 0x27, 0x6e, 0xd7, 0x37,
+	0xa3, 0x61, 0x5d, 0x39, 0x2f, 0xdb, 0x9f, 0x3f, 0xf4, 0x78, 0xf1, 0x59, 0x2b, 0xce, 0x0f, 0x5a,
+	0x34, 0xbb, 0xd9, 0xd8, 0xc6, 0xed, 0xc6, 0x36, 0xfe, 0x6c, 0x6c, 0xe3, 0xe7, 0xd6, 0xee, 0xdc,
+	0x6e, 0xed, 0xce, 0xef, 0xad, 0xdd, 0xf9, 0x36, 0x5e, 0x52, 0xb5, 0xba, 0x5a, 0x04, 0xa9, 0xe0,
+	0xe1, 0x27, 0x60, 0x54, 0xc8, 0x0b, 0x3d, 0xcd, 0xc5, 0x8c, 0x2c, 0x64, 0x78, 0x38, 0x8f, 0xb5,
+	0x3e, 0x10, 0x75, 0x9d, 0x83, 0x5c, 0xf4, 0xf4, 0x5d, 0xbc, 0xfe, 0x3b, 0x00, 0xe5, 0x43, 0xa1,
+	0x2e, 0x85, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -171,6 +184,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActivePrecompiles) > 0 {
+		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActivePrecompiles[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ActivePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -279,6 +301,12 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if len(m.ActivePrecompiles) > 0 {
+		for _, s := range m.ActivePrecompiles {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -518,6 +546,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
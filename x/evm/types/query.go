@@ -19,6 +19,10 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+// PendingTxsMaxCount is the maximum number of unconfirmed transactions applied before a pending query,
+// the following ones are ignored.
+const PendingTxsMaxCount = 256
+
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
 func (m QueryTraceTxRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, msg := range m.Predecessors {
@@ -0,0 +1,286 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/Helios-Chain-Labs/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// SetCodeTxType is the EIP-7702 set code transaction type.
+const SetCodeTxType = 0x04
+
+const (
+	// SetCodeAuthMagic is the prefix of the authorization signing payload.
+	SetCodeAuthMagic = 0x05
+	// TxAuthTupleGas is the base cost of an authorization tuple (PER_AUTH_BASE_COST).
+	TxAuthTupleGas uint64 = 12500
+	// TxAuthEmptyAccountGas is the intrinsic cost of an authorization tuple (PER_EMPTY_ACCOUNT_COST),
+	// the difference with TxAuthTupleGas is refunded when the authority already exists.
+	TxAuthEmptyAccountGas uint64 = 25000
+)
+
+// DelegationPrefix is used by code to denote the account is delegating to
+// another account.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+var (
+	ErrAuthorizationWrongChainID       = errors.New("EIP-7702 authorization chain ID mismatch")
+	ErrAuthorizationNonceOverflow      = errors.New("EIP-7702 authorization nonce > 64 bit")
+	ErrAuthorizationInvalidSignature   = errors.New("EIP-7702 authorization has invalid signature")
+	ErrAuthorizationDestinationHasCode = errors.New("EIP-7702 authorization destination is a contract")
+	ErrAuthorizationNonceMismatch      = errors.New("EIP-7702 authorization nonce does not match current account nonce")
+)
+
+// SetCodeAuthorization is an authorization from an account to deploy code at its address.
+type SetCodeAuthorization struct {
+	ChainID hexutil.Big    `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	V       hexutil.Uint64 `json:"yParity"`
+	R       hexutil.Big    `json:"r"`
+	S       hexutil.Big    `json:"s"`
+}
+
+// setCodeAuthorizationRLP is the RLP encoding of an authorization tuple.
+type setCodeAuthorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint64
+	R       *big.Int
+	S       *big.Int
+}
+
+// EncodeRLP implements rlp.Encoder.
+func (a SetCodeAuthorization) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, &setCodeAuthorizationRLP{
+		ChainID: a.ChainID.ToInt(),
+		Address: a.Address,
+		Nonce:   uint64(a.Nonce),
+		V:       uint64(a.V),
+		R:       a.R.ToInt(),
+		S:       a.S.ToInt(),
+	})
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (a *SetCodeAuthorization) DecodeRLP(s *rlp.Stream) error {
+	var dec setCodeAuthorizationRLP
+	if err := s.Decode(&dec); err != nil {
+		return err
+	}
+	*a = SetCodeAuthorization{
+		ChainID: hexutil.Big(*dec.ChainID),
+		Address: dec.Address,
+		Nonce:   hexutil.Uint64(dec.Nonce),
+		V:       hexutil.Uint64(dec.V),
+		R:       hexutil.Big(*dec.R),
+		S:       hexutil.Big(*dec.S),
+	}
+	return nil
+}
+
+// SigHash returns the hash of the authorization to be signed by the authority.
+func (a SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRlpHash(SetCodeAuthMagic, []interface{}{
+		a.ChainID.ToInt(),
+		a.Address,
+		uint64(a.Nonce),
+	})
+}
+
+// Authority recovers the authorizing account of the authorization.
+func (a SetCodeAuthorization) Authority() (common.Address, error) {
+	addr, err := recoverPlain(a.SigHash(), uint64(a.V), a.R.ToInt(), a.S.ToInt())
+	if err != nil {
+		return common.Address{}, errors.Join(ErrAuthorizationInvalidSignature, err)
+	}
+	return addr, nil
+}
+
+// SignSetCode signs the SetCode authorization with the given key.
+func SignSetCode(prv *ecdsa.PrivateKey, auth SetCodeAuthorization) (SetCodeAuthorization, error) {
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), prv)
+	if err != nil {
+		return SetCodeAuthorization{}, err
+	}
+	auth.R = hexutil.Big(*new(big.Int).SetBytes(sig[:32]))
+	auth.S = hexutil.Big(*new(big.Int).SetBytes(sig[32:64]))
+	auth.V = hexutil.Uint64(sig[64])
+	return auth, nil
+}
+
+// ParseDelegation tries to parse the address from a delegation slice.
+func ParseDelegation(b []byte) (common.Address, bool) {
+	if len(b) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(b, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(b[len(DelegationPrefix):]), true
+}
+
+// AddressToDelegation adds the delegation prefix to the specified address.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// SetCodeTx is the EIP-7702 set code transaction. go-ethereum v1.14 has no type-4 envelope, so it's
+// carried by EthereumTx next to a dynamic fee transaction with the same fields.
+type SetCodeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []SetCodeAuthorization
+
+	// Signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// DecodeSetCodeTx decodes the canonical encoding of a set code transaction.
+func DecodeSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+	tx := new(SetCodeTx)
+	if err := rlp.DecodeBytes(b[1:], tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	bz, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{SetCodeTxType}, bz...), nil
+}
+
+// Hash returns the transaction hash.
+func (tx *SetCodeTx) Hash() common.Hash {
+	return prefixedRlpHash(SetCodeTxType, tx)
+}
+
+// SigHash returns the hash of the transaction to be signed by the sender.
+func (tx *SetCodeTx) SigHash() common.Hash {
+	return prefixedRlpHash(SetCodeTxType, []interface{}{
+		tx.ChainID,
+		tx.Nonce,
+		tx.GasTipCap,
+		tx.GasFeeCap,
+		tx.Gas,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList,
+		tx.AuthList,
+	})
+}
+
+// Sender recovers the sender of the transaction, which must be signed for the given chain id.
+func (tx *SetCodeTx) Sender(chainID *big.Int) (common.Address, error) {
+	if tx.ChainID == nil || chainID == nil || tx.ChainID.Cmp(chainID) != 0 {
+		return common.Address{}, fmt.Errorf("%w: have %d want %d", ethtypes.ErrInvalidChainId, tx.ChainID, chainID)
+	}
+	if tx.V == nil || !tx.V.IsUint64() {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	return recoverPlain(tx.SigHash(), tx.V.Uint64(), tx.R, tx.S)
+}
+
+// WithSignature returns a copy of the transaction with the given [R || S || V] signature.
+func (tx *SetCodeTx) WithSignature(sig []byte) (*SetCodeTx, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+	cpy := *tx
+	cpy.R = new(big.Int).SetBytes(sig[:32])
+	cpy.S = new(big.Int).SetBytes(sig[32:64])
+	cpy.V = new(big.Int).SetBytes([]byte{sig[64]})
+	return &cpy, nil
+}
+
+// AsDynamicFeeTx returns the dynamic fee transaction with the same fields and signature, it exposes them
+// through ethtypes.Transaction. Its hash and encoding are not the ones of the set code transaction.
+func (tx *SetCodeTx) AsDynamicFeeTx() *ethtypes.Transaction {
+	to := tx.To
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:    tx.ChainID,
+		Nonce:      tx.Nonce,
+		GasTipCap:  tx.GasTipCap,
+		GasFeeCap:  tx.GasFeeCap,
+		Gas:        tx.Gas,
+		To:         &to,
+		Value:      tx.Value,
+		Data:       tx.Data,
+		AccessList: tx.AccessList,
+		V:          tx.V,
+		R:          tx.R,
+		S:          tx.S,
+	})
+}
+
+// prefixedRlpHash returns the hash of the RLP encoding of x prefixed with the type byte.
+func prefixedRlpHash(prefix byte, x interface{}) common.Hash {
+	bz, err := rlp.EncodeToBytes(x)
+	if err != nil {
+		// only fails on unsupported types
+		panic(err)
+	}
+	return crypto.Keccak256Hash([]byte{prefix}, bz)
+}
+
+// recoverPlain recovers the address of the signer of the hash, the v value is the y parity of the signature.
+func recoverPlain(hash common.Hash, v uint64, r, s *big.Int) (common.Address, error) {
+	if v > 1 || r == nil || s == nil || r.BitLen() > 256 || s.BitLen() > 256 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	if !crypto.ValidateSignatureValues(byte(v), r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = byte(v)
+
+	pub, err := crypto.Ecrecover(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	return common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]), nil
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/Helios-Chain-Labs/ethermint/x/evm/types"
+)
+
+func TestSetCodeAuthorityRecovery(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	auth, err := evmtypes.SignSetCode(key, evmtypes.SetCodeAuthorization{
+		ChainID: hexutil.Big(*big.NewInt(9000)),
+		Address: common.HexToAddress("0x0000000000000000000000000000000000001234"),
+		Nonce:   1,
+	})
+	require.NoError(t, err)
+
+	authority, err := auth.Authority()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), authority)
+
+	// the signature doesn't cover another nonce
+	auth.Nonce = 2
+	authority, err = auth.Authority()
+	require.NoError(t, err)
+	require.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), authority)
+
+	auth.V = 2
+	_, err = auth.Authority()
+	require.ErrorIs(t, err, evmtypes.ErrAuthorizationInvalidSignature)
+}
+
+func TestParseDelegation(t *testing.T) {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000001234")
+
+	target, ok := evmtypes.ParseDelegation(evmtypes.AddressToDelegation(addr))
+	require.True(t, ok)
+	require.Equal(t, addr, target)
+
+	_, ok = evmtypes.ParseDelegation(addr.Bytes())
+	require.False(t, ok)
+	_, ok = evmtypes.ParseDelegation(append(evmtypes.AddressToDelegation(addr), 0))
+	require.False(t, ok)
+}
@@ -16,15 +16,15 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
-	"github.com/ethereum/go-ethereum/params"
 
 	// importing the tracers package automatically triggers its init method which
 	// registers the firehose tracer in the LiveDirectory
@@ -41,8 +41,9 @@ const (
 )
 
 // NewTracer creates a new Logger tracer to collect execution traces from an
-// EVM transaction.
-func NewTracer(tracer string, msg *core.Message, rules params.Rules) *tracers.Tracer {
+// EVM transaction. The precompiles are the addresses of the active native and
+// custom precompiled contracts, which the access list tracer leaves out.
+func NewTracer(tracer string, msg *core.Message, precompiles []common.Address) *tracers.Tracer {
 	// TODO: enable additional log configuration
 	logCfg := &logger.Config{
 		Debug: true,
@@ -52,8 +53,13 @@ func NewTracer(tracer string, msg *core.Message, rules params.Rules) *tracers.Tr
 
 	switch tracer {
 	case TracerAccessList:
-		preCompiles := vm.ActivePrecompiles(rules)
-		hooks = logger.NewAccessListTracer(msg.AccessList, msg.From, *msg.To, preCompiles).Hooks()
+		t := logger.NewAccessListTracer(msg.AccessList, msg.From, *msg.To, precompiles)
+		return &tracers.Tracer{
+			Hooks: t.Hooks(),
+			GetResult: func() (json.RawMessage, error) {
+				return json.Marshal(t.AccessList())
+			},
+		}
 	case TracerJSON:
 		hooks = logger.NewJSONLogger(logCfg, os.Stderr)
 	case TracerMarkdown:
@@ -50,6 +50,9 @@ type TransactionArgs struct {
 	// Introduced by AccessListTxType transaction.
 	AccessList *types.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big      `json:"chainId,omitempty"`
+
+	// Introduced by SetCodeTxType transaction.
+	AuthorizationList []SetCodeAuthorization `json:"authorizationList,omitempty"`
 }
 
 // String return the struct in a string format
@@ -80,6 +83,31 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 
 	var data types.TxData
 	switch {
+	case len(args.AuthorizationList) > 0:
+		al := types.AccessList{}
+		if args.AccessList != nil {
+			al = *args.AccessList
+		}
+		var to common.Address
+		if args.To != nil {
+			to = *args.To
+		}
+		tx := NewSetCodeTx(&SetCodeTx{
+			ChainID:    (*big.Int)(args.ChainID),
+			Nonce:      nonce,
+			GasTipCap:  (*big.Int)(args.MaxPriorityFeePerGas),
+			GasFeeCap:  (*big.Int)(args.MaxFeePerGas),
+			Gas:        gas,
+			To:         to,
+			Value:      (*big.Int)(args.Value),
+			Data:       args.GetData(),
+			AccessList: al,
+			AuthList:   args.AuthorizationList,
+		})
+		if args.From != nil {
+			tx.From = args.From.Bytes()
+		}
+		return tx
 	case args.MaxFeePerGas != nil:
 		al := types.AccessList{}
 		if args.AccessList != nil {
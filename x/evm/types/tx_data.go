@@ -26,6 +26,7 @@ var (
 	_ TxData = &LegacyTx{}
 	_ TxData = &AccessListTx{}
 	_ TxData = &DynamicFeeTx{}
+	_ TxData = &BlobTx{}
 )
 
 // TxData implements the Ethereum transaction tx structure. It is used
@@ -68,6 +69,8 @@ func NewTxDataFromTx(tx *ethtypes.Transaction) (TxData, error) {
 	var txData TxData
 	var err error
 	switch tx.Type() {
+	case ethtypes.BlobTxType:
+		txData, err = newBlobTx(tx)
 	case ethtypes.DynamicFeeTxType:
 		txData, err = newDynamicFeeTx(tx)
 	case ethtypes.AccessListTxType:
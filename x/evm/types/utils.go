@@ -211,7 +211,7 @@ func UnwrapEthereumMsg(tx *sdk.Tx, ethHash common.Hash) (*MsgEthereumTx, error)
 		if !ok {
 			return nil, fmt.Errorf("invalid tx type: %T", tx)
 		}
-		txHash := ethMsg.AsTransaction().Hash()
+		txHash := ethMsg.Hash()
 		if txHash == ethHash {
 			return ethMsg, nil
 		}